@@ -0,0 +1,111 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"flag"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/initializers"
+	"github.com/zeze1004/image-hub-platform/services"
+	"io/fs"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// 폴더나 ZIP의 이미지를 메타데이터 파일(CSV, JSON)에 따라 각 소유자에게 일괄 업로드
+// 이미 가져온 파일은 건너뛰므로 실패하거나 중단되면 같은 명령으로 다시 실행하면 이어서 가져옴
+// 이미지는 서버와 같은 uploads 디렉토리에 저장되므로 서버와 같은 작업 디렉토리에서 실행
+//
+//	go run ./cmd/import -source ./photos.zip -report import-report.csv
+func main() {
+	source := flag.String("source", "", "가져올 폴더 또는 ZIP 파일 경로")
+	metadata := flag.String("metadata", "", "메타데이터 파일(CSV, JSON) 경로, 없으면 source 최상위의 metadata.csv 또는 metadata.json 사용")
+	workers := flag.Int("workers", services.DefaultBulkImportWorkers, "동시에 가져올 파일 수")
+	reportPath := flag.String("report", "import-report.csv", "항목별 결과를 기록할 CSV 파일 경로")
+	flag.Parse()
+
+	if *source == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	fsys, closeSource, err := openSource(*source)
+	if err != nil {
+		log.Fatalf("가져올 파일을 여는데 실패했습니다: %v", err)
+	}
+	defer closeSource()
+
+	cfg := initializers.InitConfig()
+	db := initializers.InitDB(cfg.Database)
+	bulkImportService := initializers.InitBulkImportService(db, cfg)
+
+	var items []services.BulkImportItem
+	if *metadata != "" {
+		file, err := os.Open(*metadata)
+		if err != nil {
+			log.Fatalf("메타데이터 파일을 여는데 실패했습니다: %v", err)
+		}
+		items, err = bulkImportService.ParseSidecar(file, *metadata)
+		file.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else if items, err = bulkImportService.LoadSidecar(fsys); err != nil {
+		log.Fatal(err)
+	}
+
+	reportFile, err := os.Create(*reportPath)
+	if err != nil {
+		log.Fatalf("결과 파일을 만드는데 실패했습니다: %v", err)
+	}
+	defer reportFile.Close()
+	report := services.NewBulkImportReport(reportFile)
+
+	// 중단해도 이미 끝난 항목은 결과 파일에 남도록 항목마다 기록
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	counts := make(map[string]int)
+	done := 0
+	var reportErr error
+	results := bulkImportService.ImportImages(ctx, fsys, items, *workers, func(result services.BulkImportResult) {
+		if err := report.Write(result); err != nil {
+			reportErr = err
+		}
+
+		counts[result.Status]++
+		done++
+		if done%100 == 0 {
+			log.Printf("%d/%d개 처리했습니다", done, len(items))
+		}
+	})
+	if reportErr != nil {
+		log.Printf("결과 파일을 쓰는데 실패했습니다: %v", reportErr)
+	}
+
+	fmt.Printf("가져옴: %d, 건너뜀: %d, 실패: %d, 남은 항목: %d (결과: %s)\n",
+		counts[services.BulkImportStatusImported], counts[services.BulkImportStatusSkipped],
+		counts[services.BulkImportStatusFailed], len(items)-len(results), *reportPath)
+	if counts[services.BulkImportStatusFailed] > 0 || len(results) < len(items) {
+		os.Exit(1)
+	}
+}
+
+// openSource 폴더 또는 ZIP 파일을 fs.FS로 열기
+func openSource(source string) (fs.FS, func() error, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.IsDir() {
+		return os.DirFS(source), func() error { return nil }, nil
+	}
+
+	zipReader, err := zip.OpenReader(source)
+	if err != nil {
+		return nil, nil, err
+	}
+	return zipReader, zipReader.Close, nil
+}
@@ -1,76 +1,53 @@
 package main
 
 import (
-	"github.com/gin-gonic/gin"
+	"context"
 	"github.com/zeze1004/image-hub-platform/initializers"
-	"github.com/zeze1004/image-hub-platform/middlewares"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
+	"github.com/zeze1004/image-hub-platform/server"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 func main() {
-	// DB 초기화
-	db := initializers.InitDB()
-
-	// 모듈 초기화
-	authController := initializers.InitUserModule(db)
-	imageController := initializers.InitImageModule(db)
-	categoryController := initializers.InitCategoryModule(db)
-
-	r := gin.Default()
-
-	auth := r.Group("/auth")
-	{
-		auth.POST("/signup", authController.SignUp)
-		auth.POST("/login", authController.Login)
+	// 설정, DB 초기화
+	cfg := initializers.InitConfig()
+	db := initializers.InitDB(cfg.Database)
+
+	// SIGINT, SIGTERM을 받으면 처리 중인 요청을 마친 뒤 종료
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// 종료 신호를 받자마자 /readyz가 실패하도록 백그라운드 작업 종료를 시작
+	background := lifecycle.NewRegistry()
+	context.AfterFunc(ctx, background.Stop)
+
+	handler := server.NewHandler(cfg, server.Dependencies{DB: db, Lifecycle: background})
+	serveErr := server.ListenAndServe(ctx, cfg.Server, handler)
+	if serveErr != nil {
+		log.Printf("서버가 비정상 종료됐습니다: %v", serveErr)
 	}
 
-	api := r.Group("/api", middlewares.JWTAuthMiddleware()) // JWT 인증 미들웨어를 타는 API 그룹
-
-	userAPI := api.Group("/user")              // user용 엔드포인트
-	userAPI.Use(middlewares.RequireUserRole()) // user 권한 검증 미들웨어
-	{
-		// 이미지 API
-		imageAPI := userAPI.Group("/images")
-		imageAPI.POST("", imageController.UploadImage)
-
-		imageAPI.GET("", imageController.GetImagesByUserID)
-		imageAPI.GET("/thumbnail/:imageID/", imageController.GetThumbnail)              // 썸네일 조회 엔드포인트
-		imageAPI.GET("/:imageID/categories", categoryController.GetCategoriesByImageID) // 카테고리별 이미지 조회
-		imageAPI.GET("/:imageID/", imageController.GetImageByID)
-
-		imageAPI.DELETE("", imageController.DeleteAllUserImages)
-		imageAPI.DELETE("/:imageID", imageController.DeleteImage)
-
-		// 카테고리 API
-		categoryAPI := userAPI.Group("/categories")
-
-		categoryAPI.GET("/:categoryID/images", imageController.GetImagesByCategoryID)
-		categoryAPI.POST("/:categoryID/images/:imageID/", categoryController.AddCategoryToImage)
-		categoryAPI.DELETE("/:categoryID/images/:imageID/", categoryController.RemoveCategoryFromImage)
+	// 요청 처리가 끝난 뒤 요청이 남긴 파일 삭제와 내보내기, 정리 작업이 끝날 때까지 다시 cfg.Server.ShutdownTimeout 동안 기다림
+	log.Println("백그라운드 작업이 끝나기를 기다립니다")
+	shutdownCtx := context.Background()
+	if cfg.Server.ShutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		shutdownCtx, cancel = context.WithTimeout(shutdownCtx, time.Duration(cfg.Server.ShutdownTimeout))
+		defer cancel()
 	}
-
-	adminAPI := api.Group("/admin")              // admin용 엔드포인트
-	adminAPI.Use(middlewares.RequireAdminRole()) // admin 권한 검증 미들웨어
-	{
-		// 이미지 API
-		imageAPI := adminAPI.Group("/images")
-
-		imageAPI.POST(":userID/", imageController.UploadImage)
-
-		imageAPI.GET("", imageController.GetAllImagesByAdmin)
-		imageAPI.GET("users/:userID/images", imageController.GetImagesByUserID)
-		imageAPI.GET("/:imageID/", imageController.GetImageByID)
-
-		imageAPI.DELETE("/users/:userID/images", imageController.DeleteAllUserImages)
-		imageAPI.DELETE("/:imageID/", imageController.DeleteImage)
-		imageAPI.GET("/:imageID/categories", categoryController.GetCategoriesByImageID) // 카테고리별 이미지 조회
-
-		// 카테고리 API
-		categoryAPI := adminAPI.Group("/categories")
-
-		categoryAPI.GET("/:categoryID/images", imageController.GetImagesByCategoryID)
-		categoryAPI.POST("/:categoryID/images/:imageID/", categoryController.AddCategoryToImage)
-		categoryAPI.DELETE("/:categoryID/images/:imageID/", categoryController.RemoveCategoryFromImage)
+	if err := background.Shutdown(shutdownCtx); err != nil {
+		log.Printf("백그라운드 작업을 모두 마치지 못하고 종료합니다: %v", err)
 	}
 
-	_ = r.Run()
+	if sqlDB, err := db.DB(); err == nil {
+		sqlDB.Close()
+	}
+	if serveErr != nil {
+		os.Exit(1)
+	}
+	log.Println("서버를 종료했습니다")
 }
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/initializers"
+	"github.com/zeze1004/image-hub-platform/migrations"
+	"log"
+	"os"
+)
+
+// 스키마 마이그레이션 적용, 되돌리기, 상태 확인
+// -seed를 주면 기본 카테고리와 관리자 계정 시드 데이터도 함께 다룸
+// -seed가 없으면 시드 데이터 마이그레이션은 적용했더라도 없는 것처럼 다루므로, down은 스키마 마이그레이션만 되돌리고 status에도 나오지 않음
+//
+//	go run ./cmd/migrate up
+//	go run ./cmd/migrate -seed -admin-email admin@example.com -admin-password '...' up
+//	go run ./cmd/migrate down
+//	go run ./cmd/migrate status
+func main() {
+	seed := flag.Bool("seed", false, "시드 데이터 마이그레이션(기본 카테고리, 관리자 계정)도 포함")
+	adminEmail := flag.String("admin-email", envOrDefault("ADMIN_EMAIL", "admin@example.com"), "시드 데이터로 만들 관리자 이메일 (ADMIN_EMAIL)")
+	adminPassword := flag.String("admin-password", os.Getenv("ADMIN_PASSWORD"), "시드 데이터로 만들 관리자 비밀번호, 8자 이상 (ADMIN_PASSWORD)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "사용법: %s [flags] up|down|status\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// 마이그레이션에는 JWT, 파일 URL 서명 키가 필요 없으므로 DB 설정만 읽고 검증
+	db, err := initializers.OpenDB(initializers.InitDatabaseConfig())
+	if err != nil {
+		log.Fatalf("DB 연결에 실패했습니다: %v", err)
+	}
+
+	list := migrations.Schema()
+	if *seed {
+		list = append(list, migrations.Seeds(migrations.AdminAccount{Email: *adminEmail, Password: *adminPassword})...)
+	}
+	migrator := migrations.NewMigrator(db, list)
+
+	switch flag.Arg(0) {
+	case "up":
+		applied, err := migrator.Up()
+		for _, migration := range applied {
+			fmt.Printf("적용: %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("적용할 마이그레이션이 없습니다")
+		}
+	case "down":
+		reverted, err := migrator.Down()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if reverted == nil {
+			fmt.Println("되돌릴 마이그레이션이 없습니다")
+			return
+		}
+		fmt.Printf("되돌림: %04d_%s\n", reverted.Version, reverted.Name)
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, status := range statuses {
+			applied := "미적용"
+			if status.AppliedAt != nil {
+				applied = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-20s %s\n", status.Version, status.Name, applied)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,328 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 애플리케이션 설정
+// 기본값 -> 설정 파일(JSON) -> 환경변수 순서로 덮어쓰고, 서버 시작 전에 검증한다.
+
+const redacted = "********" // 설정 내용을 출력할 때 비밀 값 대신 표시
+
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite" // cgo가 필요 없는 순수 Go 드라이버, 개발과 테스트용
+)
+
+// 드라이버별 기본 포트와 접속 옵션
+var (
+	defaultDBPorts  = map[string]int{DriverMySQL: 3306, DriverPostgres: 5432}
+	defaultDBParams = map[string]string{
+		DriverMySQL:    "charset=utf8&parseTime=True&loc=Local",
+		DriverPostgres: "sslmode=disable",
+		DriverSQLite:   "_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)",
+	}
+)
+
+type Config struct {
+	Server   ServerConfig   `json:"server"`
+	Database DatabaseConfig `json:"database"`
+	Auth     AuthConfig     `json:"auth"`
+	Storage  StorageConfig  `json:"storage"`
+	Image    ImageConfig    `json:"image"`
+	Import   ImportConfig   `json:"import"`
+}
+
+type ServerConfig struct {
+	Addr            string   `json:"addr"`             // 서버 주소 (SERVER_ADDR)
+	TLSCertFile     string   `json:"tls_cert_file"`    // TLS 인증서 파일 (SERVER_TLS_CERT_FILE), 키 파일과 함께 지정하면 HTTPS로 실행
+	TLSKeyFile      string   `json:"tls_key_file"`     // TLS 개인 키 파일 (SERVER_TLS_KEY_FILE)
+	ReadTimeout     Duration `json:"read_timeout"`     // 요청 본문까지 읽는 제한 시간 (SERVER_READ_TIMEOUT), 0이면 제한 없음
+	WriteTimeout    Duration `json:"write_timeout"`    // 응답을 쓰는 제한 시간 (SERVER_WRITE_TIMEOUT), 0이면 제한 없음
+	IdleTimeout     Duration `json:"idle_timeout"`     // keep-alive 연결을 유지하는 시간 (SERVER_IDLE_TIMEOUT)
+	ShutdownTimeout Duration `json:"shutdown_timeout"` // 종료 신호를 받은 뒤 처리 중인 요청을 기다리는 시간 (SERVER_SHUTDOWN_TIMEOUT)
+	DrainDelay      Duration `json:"drain_delay"`      // 종료 신호를 받은 뒤 /readyz만 실패시키고 요청을 계속 받는 시간 (SERVER_DRAIN_DELAY), 로드밸런서가 서버를 뺄 때까지 기다림
+}
+
+// TLS 인증서와 키 파일이 모두 지정됐는지
+func (c ServerConfig) TLS() bool {
+	return c.TLSCertFile != "" && c.TLSKeyFile != ""
+}
+
+type DatabaseConfig struct {
+	Driver      string `json:"driver"`       // mysql, postgres, sqlite (DB_DRIVER)
+	Host        string `json:"host"`         // DB_HOST
+	Port        int    `json:"port"`         // DB_PORT, 0이면 드라이버 기본 포트
+	User        string `json:"user"`         // DB_USER
+	Password    string `json:"password"`     // DB_PASS, 비밀 값
+	Name        string `json:"name"`         // DB_NAME
+	Path        string `json:"path"`         // SQLite 데이터베이스 파일 경로 (DB_PATH), ":memory:"이면 메모리에만 저장
+	Params      string `json:"params"`       // DSN 뒤에 붙는 접속 옵션 (DB_PARAMS), 비어 있으면 드라이버 기본 옵션
+	AutoMigrate bool   `json:"auto_migrate"` // 시작할 때 적용하지 않은 스키마 마이그레이션 적용 (DB_AUTO_MIGRATE)
+}
+
+type AuthConfig struct {
+	JWTSecret     string   `json:"jwt_secret"`      // JWT 서명 키 (JWT_SECRET), 비밀 값
+	TokenTTL      Duration `json:"token_ttl"`       // JWT 유효 기간 (JWT_TOKEN_TTL)
+	URLSigningKey string   `json:"url_signing_key"` // 파일 URL 서명 키 (URL_SIGNING_KEY), 비밀 값, JWT 서명 키와 다른 값이어야 함
+}
+
+type StorageConfig struct {
+	UploadsDir string `json:"uploads_dir"` // 원본, 썸네일, 업로드 중인 파일을 저장하는 디렉토리 (UPLOADS_DIR)
+}
+
+type ImageConfig struct {
+	ThumbnailWidth  uint `json:"thumbnail_width"`  // 썸네일 최대 너비 (THUMBNAIL_WIDTH)
+	ThumbnailHeight uint `json:"thumbnail_height"` // 썸네일 최대 높이 (THUMBNAIL_HEIGHT)
+}
+
+type ImportConfig struct {
+	// URL로 이미지 가져오기에서 허용할 내부 네트워크 CIDR (IMPORT_ALLOWED_NETWORKS, 쉼표로 구분)
+	AllowedNetworks []string `json:"allowed_networks"`
+}
+
+// Duration 설정 파일에서 "24h" 같은 문자열로 쓰는 시간 간격
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("시간 간격은 \"24h\" 같은 문자열이어야 합니다")
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+// Default 설정 파일과 환경변수가 없을 때 사용하는 기본 설정
+// JWT 서명 키는 기본값이 없으므로 설정 파일이나 환경변수로 지정해야 함
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Addr: ":8080",
+			// 큰 이미지 업로드와 ZIP 다운로드가 끊기지 않도록 넉넉하게 설정
+			ReadTimeout:     Duration(10 * time.Minute),
+			WriteTimeout:    Duration(10 * time.Minute),
+			IdleTimeout:     Duration(2 * time.Minute),
+			ShutdownTimeout: Duration(30 * time.Second),
+		},
+		Database: DatabaseConfig{
+			Driver: DriverMySQL,
+			Host:   "127.0.0.1",
+			User:   "root",
+			Name:   "image_hub",
+			Path:   "image_hub.db",
+		},
+		Auth:    AuthConfig{TokenTTL: Duration(24 * time.Hour)},
+		Storage: StorageConfig{UploadsDir: "./uploads"},
+		Image:   ImageConfig{ThumbnailWidth: 150, ThumbnailHeight: 150},
+	}
+}
+
+// Load 기본 설정에 path의 설정 파일과 환경변수를 덮어쓰고 검증, path가 비어 있으면 설정 파일은 읽지 않음
+func Load(path string) (*Config, error) {
+	cfg, err := read(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// LoadDatabase Load처럼 설정을 읽지만 DB 설정만 검증해서 반환
+// JWT, 파일 URL 서명 키를 쓰지 않는 cmd/migrate가 서버용 비밀 값 없이 실행할 수 있도록 함
+func LoadDatabase(path string) (DatabaseConfig, error) {
+	cfg, err := read(path)
+	if err != nil {
+		return DatabaseConfig{}, err
+	}
+	if errs := cfg.Database.validate(); len(errs) > 0 {
+		return DatabaseConfig{}, fmt.Errorf("잘못된 설정입니다: %w", errors.Join(errs...))
+	}
+	return cfg.Database, nil
+}
+
+// read 기본 설정에 path의 설정 파일과 환경변수를 덮어씀
+func read(path string) (*Config, error) {
+	cfg := Default()
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.applyEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("설정 파일을 여는데 실패했습니다: %v", err)
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	decoder.DisallowUnknownFields() // 오타가 난 설정 이름을 조용히 무시하지 않음
+	if err := decoder.Decode(c); err != nil {
+		return fmt.Errorf("설정 파일 %s를 읽는데 실패했습니다: %v", path, err)
+	}
+	return nil
+}
+
+// Validate 설정 값 검증, 잘못된 값을 모두 모아서 반환
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Server.Addr == "" {
+		errs = append(errs, errors.New("server.addr가 필요합니다"))
+	}
+	if (c.Server.TLSCertFile == "") != (c.Server.TLSKeyFile == "") {
+		errs = append(errs, errors.New("server.tls_cert_file과 server.tls_key_file은 함께 지정해야 합니다"))
+	}
+	if c.Server.ReadTimeout < 0 || c.Server.WriteTimeout < 0 || c.Server.IdleTimeout < 0 || c.Server.ShutdownTimeout < 0 || c.Server.DrainDelay < 0 {
+		errs = append(errs, errors.New("server의 timeout은 0 이상이어야 합니다"))
+	}
+	errs = append(errs, c.Database.validate()...)
+	if len(c.Auth.JWTSecret) < 16 {
+		errs = append(errs, errors.New("auth.jwt_secret(JWT_SECRET)는 16자 이상이어야 합니다"))
+	}
+	switch {
+	case len(c.Auth.URLSigningKey) < 16:
+		errs = append(errs, errors.New("auth.url_signing_key(URL_SIGNING_KEY)는 16자 이상이어야 합니다"))
+	case c.Auth.URLSigningKey == c.Auth.JWTSecret:
+		errs = append(errs, errors.New("auth.url_signing_key(URL_SIGNING_KEY)는 auth.jwt_secret(JWT_SECRET)과 달라야 합니다"))
+	}
+	if c.Auth.TokenTTL <= 0 {
+		errs = append(errs, errors.New("auth.token_ttl은 0보다 커야 합니다"))
+	}
+	if c.Storage.UploadsDir == "" {
+		errs = append(errs, errors.New("storage.uploads_dir가 필요합니다"))
+	}
+	if c.Image.ThumbnailWidth == 0 || c.Image.ThumbnailHeight == 0 || c.Image.ThumbnailWidth > 4096 || c.Image.ThumbnailHeight > 4096 {
+		errs = append(errs, errors.New("image.thumbnail_width, image.thumbnail_height는 1 ~ 4096 사이여야 합니다"))
+	}
+	for _, network := range c.Import.AllowedNetworks {
+		if _, err := netip.ParsePrefix(network); err != nil {
+			errs = append(errs, fmt.Errorf("import.allowed_networks의 %q는 CIDR 형식이어야 합니다", network))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("잘못된 설정입니다: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// validate DB 설정 값 검증
+func (c DatabaseConfig) validate() []error {
+	var errs []error
+	switch c.Driver {
+	case DriverMySQL, DriverPostgres:
+		if c.Host == "" || c.User == "" || c.Name == "" {
+			errs = append(errs, errors.New("database.host, database.user, database.name이 필요합니다"))
+		}
+		if c.Port < 0 || c.Port > 65535 {
+			errs = append(errs, fmt.Errorf("database.port %d는 1 ~ 65535 사이여야 합니다", c.Port))
+		}
+	case DriverSQLite:
+		if c.Path == "" {
+			errs = append(errs, errors.New("SQLite는 database.path가 필요합니다"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("database.driver %q는 mysql, postgres, sqlite 중 하나여야 합니다", c.Driver))
+	}
+	return errs
+}
+
+// DSN 드라이버에 맞는 접속 문자열
+func (c DatabaseConfig) DSN() string {
+	params := c.Params
+	if params == "" {
+		params = defaultDBParams[c.Driver]
+	}
+	port := c.Port
+	if port == 0 {
+		port = defaultDBPorts[c.Driver]
+	}
+
+	switch c.Driver {
+	case DriverPostgres:
+		dsn := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(c.User, c.Password),
+			Host:     net.JoinHostPort(c.Host, strconv.Itoa(port)),
+			Path:     "/" + c.Name,
+			RawQuery: params,
+		}
+		return dsn.String()
+	case DriverSQLite:
+		if params == "" {
+			return c.Path
+		}
+		return c.Path + "?" + params
+	default:
+		return fmt.Sprintf("%s:%s@tcp(%s)/%s?%s", c.User, c.Password, net.JoinHostPort(c.Host, strconv.Itoa(port)), c.Name, params)
+	}
+}
+
+// AllowedPrefixes 허용할 내부 네트워크, Validate를 통과한 설정에서만 사용
+func (c ImportConfig) AllowedPrefixes() []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(c.AllowedNetworks))
+	for _, network := range c.AllowedNetworks {
+		if prefix, err := netip.ParsePrefix(network); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// String 비밀 값을 가린 설정 내용, 서버 시작 시 로그로 남김
+func (c *Config) String() string {
+	masked := *c
+	masked.Database.Password = redact(c.Database.Password)
+	masked.Auth.JWTSecret = redact(c.Auth.JWTSecret)
+	masked.Auth.URLSigningKey = redact(c.Auth.URLSigningKey)
+
+	data, err := json.Marshal(masked)
+	if err != nil {
+		return fmt.Sprintf("설정을 출력하는데 실패했습니다: %v", err)
+	}
+	return string(data)
+}
+
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return redacted
+}
+
+// trimList 쉼표로 구분한 환경변수 값을 목록으로 분리
+func trimList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// envVar 설정 값을 덮어쓰는 환경변수
+type envVar struct {
+	name  string
+	apply func(c *Config, value string) error
+}
+
+var envVars = []envVar{
+	{"SERVER_ADDR", func(c *Config, v string) error { c.Server.Addr = v; return nil }},
+	{"SERVER_TLS_CERT_FILE", func(c *Config, v string) error { c.Server.TLSCertFile = v; return nil }},
+	{"SERVER_TLS_KEY_FILE", func(c *Config, v string) error { c.Server.TLSKeyFile = v; return nil }},
+	{"SERVER_READ_TIMEOUT", func(c *Config, v string) error { return parseDuration(v, &c.Server.ReadTimeout) }},
+	{"SERVER_WRITE_TIMEOUT", func(c *Config, v string) error { return parseDuration(v, &c.Server.WriteTimeout) }},
+	{"SERVER_IDLE_TIMEOUT", func(c *Config, v string) error { return parseDuration(v, &c.Server.IdleTimeout) }},
+	{"SERVER_SHUTDOWN_TIMEOUT", func(c *Config, v string) error { return parseDuration(v, &c.Server.ShutdownTimeout) }},
+	{"SERVER_DRAIN_DELAY", func(c *Config, v string) error { return parseDuration(v, &c.Server.DrainDelay) }},
+	{"DB_DRIVER", func(c *Config, v string) error { c.Database.Driver = strings.ToLower(v); return nil }},
+	{"DB_HOST", func(c *Config, v string) error { c.Database.Host = v; return nil }},
+	{"DB_PORT", func(c *Config, v string) error { return parseInt(v, &c.Database.Port) }},
+	{"DB_USER", func(c *Config, v string) error { c.Database.User = v; return nil }},
+	{"DB_PASS", func(c *Config, v string) error { c.Database.Password = v; return nil }},
+	{"DB_NAME", func(c *Config, v string) error { c.Database.Name = v; return nil }},
+	{"DB_PATH", func(c *Config, v string) error { c.Database.Path = v; return nil }},
+	{"DB_PARAMS", func(c *Config, v string) error { c.Database.Params = v; return nil }},
+	{"DB_AUTO_MIGRATE", func(c *Config, v string) error { return parseBool(v, &c.Database.AutoMigrate) }},
+	{"JWT_SECRET", func(c *Config, v string) error { c.Auth.JWTSecret = v; return nil }},
+	{"JWT_TOKEN_TTL", func(c *Config, v string) error { return parseDuration(v, &c.Auth.TokenTTL) }},
+	{"URL_SIGNING_KEY", func(c *Config, v string) error { c.Auth.URLSigningKey = v; return nil }},
+	{"UPLOADS_DIR", func(c *Config, v string) error { c.Storage.UploadsDir = v; return nil }},
+	{"THUMBNAIL_WIDTH", func(c *Config, v string) error { return parseUint(v, &c.Image.ThumbnailWidth) }},
+	{"THUMBNAIL_HEIGHT", func(c *Config, v string) error { return parseUint(v, &c.Image.ThumbnailHeight) }},
+	{"IMPORT_ALLOWED_NETWORKS", func(c *Config, v string) error { c.Import.AllowedNetworks = trimList(v); return nil }},
+}
+
+// applyEnv 설정된 환경변수로 설정 값 덮어쓰기
+func (c *Config) applyEnv(lookup func(string) (string, bool)) error {
+	var errs []error
+	for _, env := range envVars {
+		value, ok := lookup(env.name)
+		if !ok {
+			continue
+		}
+		if err := env.apply(c, strings.TrimSpace(value)); err != nil {
+			errs = append(errs, fmt.Errorf("환경변수 %s: %v", env.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LoadDotEnv path의 KEY=VALUE 형식 파일을 환경변수로 설정, 이미 설정된 환경변수는 덮어쓰지 않음
+// 파일이 없으면 아무것도 하지 않음
+func LoadDotEnv(path string) error {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimPrefix(text, "export "), "=")
+		if !ok {
+			return fmt.Errorf("%s %d행: KEY=VALUE 형식이어야 합니다", path, line)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		if _, exists := os.LookupEnv(key); exists {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func parseInt(value string, target *int) error {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("%q는 정수여야 합니다", value)
+	}
+	*target = n
+	return nil
+}
+
+func parseBool(value string, target *bool) error {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("%q는 true 또는 false여야 합니다", value)
+	}
+	*target = b
+	return nil
+}
+
+func parseUint(value string, target *uint) error {
+	n, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return fmt.Errorf("%q는 0 이상의 정수여야 합니다", value)
+	}
+	*target = uint(n)
+	return nil
+}
+
+func parseDuration(value string, target *Duration) error {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%q는 24h 같은 시간 간격이어야 합니다", value)
+	}
+	*target = Duration(duration)
+	return nil
+}
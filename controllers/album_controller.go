@@ -0,0 +1,215 @@
+package controllers
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"net/http"
+)
+
+type AlbumController struct {
+	albumService services.AlbumService
+	signer       *signedurl.Signer
+}
+
+func NewAlbumController(albumService services.AlbumService, signer *signedurl.Signer) *AlbumController {
+	return &AlbumController{albumService: albumService, signer: signer}
+}
+
+// CreateAlbum 앨범 생성, ADMIN은 경로 파라미터의 userID 사용자의 앨범을 생성
+func (c *AlbumController) CreateAlbum(ctx *gin.Context) {
+	ownerID, err := c.targetUserID(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var albumReq struct {
+		Title string `json:"title" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&albumReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	album, err := c.albumService.CreateAlbum(ownerID, albumReq.Title)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "앨범이 생성됐습니다", "album": album})
+}
+
+// GetAlbums 사용자의 앨범 목록을 미리보기 이미지와 함께 조회
+func (c *AlbumController) GetAlbums(ctx *gin.Context) {
+	userID, err := c.targetUserID(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	albums, err := c.albumService.GetAlbumsByUserID(userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	for i := range albums {
+		utils.SignImageURLs(c.signer, albums[i].Thumbnails)
+	}
+	ctx.JSON(http.StatusOK, albums)
+}
+
+// GetAlbum 앨범과 앨범의 이미지를 순서대로 조회
+func (c *AlbumController) GetAlbum(ctx *gin.Context) {
+	albumID, err := c.parseAndValidateID(ctx.Param("albumID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	album, err := c.albumService.GetAlbum(albumID, ctx.GetUint("userID"), c.isAdmin(ctx))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	utils.SignImageURLs(c.signer, album.Images)
+	ctx.JSON(http.StatusOK, album)
+}
+
+// UpdateAlbum 앨범 제목 변경 및 대표 이미지 지정, cover_image_id가 0이면 대표 이미지 해제
+func (c *AlbumController) UpdateAlbum(ctx *gin.Context) {
+	albumID, err := c.parseAndValidateID(ctx.Param("albumID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var albumReq struct {
+		Title        *string `json:"title"`
+		CoverImageID *uint   `json:"cover_image_id"`
+	}
+	if err := ctx.ShouldBindJSON(&albumReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	album, err := c.albumService.UpdateAlbum(albumID, ctx.GetUint("userID"), c.isAdmin(ctx), albumReq.Title, albumReq.CoverImageID)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "앨범이 수정됐습니다", "album": album})
+}
+
+// DeleteAlbum 앨범 삭제
+func (c *AlbumController) DeleteAlbum(ctx *gin.Context) {
+	albumID, err := c.parseAndValidateID(ctx.Param("albumID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.albumService.DeleteAlbum(albumID, ctx.GetUint("userID"), c.isAdmin(ctx)); err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "앨범 삭제가 성공했습니다"})
+}
+
+// AddImagesToAlbum 앨범에 이미지 추가
+func (c *AlbumController) AddImagesToAlbum(ctx *gin.Context) {
+	albumID, err := c.parseAndValidateID(ctx.Param("albumID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var imagesReq struct {
+		ImageIDs []uint `json:"image_ids" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&imagesReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.albumService.AddImagesToAlbum(albumID, ctx.GetUint("userID"), c.isAdmin(ctx), imagesReq.ImageIDs); err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "앨범에 이미지가 추가됐습니다"})
+}
+
+// RemoveImageFromAlbum 앨범에서 이미지 제거
+func (c *AlbumController) RemoveImageFromAlbum(ctx *gin.Context) {
+	albumID, err := c.parseAndValidateID(ctx.Param("albumID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	imageID, err := c.parseAndValidateID(ctx.Param("imageID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.albumService.RemoveImageFromAlbum(albumID, imageID, ctx.GetUint("userID"), c.isAdmin(ctx)); err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "앨범에서 이미지를 제거했습니다"})
+}
+
+// ReorderAlbumImages 앨범 이미지 순서 변경, image_ids에 앨범의 모든 이미지를 원하는 순서로 전달
+func (c *AlbumController) ReorderAlbumImages(ctx *gin.Context) {
+	albumID, err := c.parseAndValidateID(ctx.Param("albumID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var orderReq struct {
+		ImageIDs []uint `json:"image_ids" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&orderReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.albumService.ReorderAlbumImages(albumID, ctx.GetUint("userID"), c.isAdmin(ctx), orderReq.ImageIDs); err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "앨범 이미지 순서가 변경됐습니다"})
+}
+
+// errorStatus 앨범 서비스 에러를 HTTP 상태 코드로 변환
+func (c *AlbumController) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrInvalidAlbumRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, services.ErrAlbumImageNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusForbidden
+	}
+}
+
+// targetUserID ADMIN 요청이면 경로 파라미터의 userID, 아니면 요청한 사용자의 ID
+func (c *AlbumController) targetUserID(ctx *gin.Context) (uint, error) {
+	if c.isAdmin(ctx) {
+		return c.parseAndValidateID(ctx.Param("userID"))
+	}
+	return ctx.GetUint("userID"), nil
+}
+
+// isAdmin 관리자 권한인지 확인
+func (c *AlbumController) isAdmin(ctx *gin.Context) bool {
+	return utils.IsAdmin(ctx)
+}
+
+// parseAndValidateID ID 파라미터 파싱 및 유효성 검사
+func (c *AlbumController) parseAndValidateID(paramID string) (uint, error) {
+	return utils.ParseAndValidateID(paramID)
+}
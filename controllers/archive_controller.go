@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"log"
+	"net/http"
+	"time"
+)
+
+type ArchiveController struct {
+	archiveService services.ArchiveService
+}
+
+func NewArchiveController(archiveService services.ArchiveService) *ArchiveController {
+	return &ArchiveController{archiveService: archiveService}
+}
+
+// CreateArchive 선택한 이미지를 ZIP으로 압축해서 스트리밍
+// image_ids, album_id(앨범 순서대로)를 보내거나, category_id, from, to(YYYY-MM-DD, to 날짜 포함) 조건으로 선택, 관리자는 user_id도 지정 가능
+func (c *ArchiveController) CreateArchive(ctx *gin.Context) {
+	var archiveReq struct {
+		ImageIDs   []uint `json:"image_ids"`
+		AlbumID    uint   `json:"album_id"`
+		CategoryID uint   `json:"category_id"`
+		UserID     uint   `json:"user_id"`
+		From       string `json:"from"`
+		To         string `json:"to"`
+	}
+	if err := ctx.ShouldBindJSON(&archiveReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filter := services.ArchiveFilter{
+		ImageIDs:   archiveReq.ImageIDs,
+		AlbumID:    archiveReq.AlbumID,
+		CategoryID: archiveReq.CategoryID,
+		UserID:     archiveReq.UserID,
+	}
+	if archiveReq.From != "" {
+		from, err := c.parseDate(archiveReq.From)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		filter.From = &from
+	}
+	if archiveReq.To != "" {
+		to, err := c.parseDate(archiveReq.To)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	images, err := c.archiveService.GetArchiveImages(filter, ctx.GetUint("userID"), c.isAdmin(ctx))
+	if err != nil {
+		status := http.StatusForbidden
+		if errors.Is(err, services.ErrInvalidArchiveRequest) {
+			status = http.StatusBadRequest
+		} else if errors.Is(err, services.ErrNoArchiveImages) || errors.Is(err, utils.ErrImageNotFound) {
+			status = http.StatusNotFound
+		}
+		ctx.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+
+	fileName := fmt.Sprintf("images-%s.zip", time.Now().Format("20060102-150405"))
+	ctx.Header("Content-Type", "application/zip")
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	ctx.Status(http.StatusOK)
+
+	// 이미 응답을 보내기 시작했으므로 실패하면 로그만 남기고, 클라이언트는 잘린 압축 파일을 받게 됨
+	if err := c.archiveService.WriteArchive(ctx.Writer, images, services.ArchiveOptions{}); err != nil {
+		log.Printf("이미지 압축 파일을 보내는데 실패했습니다: %v", err)
+	}
+}
+
+// parseDate YYYY-MM-DD 형식의 날짜 파싱
+func (c *ArchiveController) parseDate(value string) (time.Time, error) {
+	date, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return time.Time{}, errors.New("날짜는 YYYY-MM-DD 형식이어야 합니다")
+	}
+	return date, nil
+}
+
+// isAdmin 관리자 권한인지 확인
+func (c *ArchiveController) isAdmin(ctx *gin.Context) bool {
+	return utils.IsAdmin(ctx)
+}
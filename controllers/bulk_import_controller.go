@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"io"
+	"net/http"
+)
+
+type BulkImportController struct {
+	bulkImportJobService services.BulkImportJobService
+}
+
+func NewBulkImportController(bulkImportJobService services.BulkImportJobService) *BulkImportController {
+	return &BulkImportController{bulkImportJobService: bulkImportJobService}
+}
+
+// ImportArchive ZIP 파일의 이미지를 메타데이터 파일에 따라 각 소유자에게 일괄 업로드하는 작업 요청 (관리자)
+// 메타데이터는 metadata 파트(CSV, JSON)로 보내거나 ZIP 최상위의 metadata.csv, metadata.json을 사용
+// ZIP과 메타데이터 파일만 검증하고 가져오기는 백그라운드에서 처리, 진행 상황과 결과 파일은 작업 조회로 확인
+func (c *BulkImportController) ImportArchive(ctx *gin.Context) {
+	archiveHeader, err := ctx.FormFile("archive")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "archive 파일이 필요합니다"})
+		return
+	}
+	archiveFile, err := archiveHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer archiveFile.Close()
+
+	var metadata io.Reader
+	var metadataName string
+	if metadataHeader, err := ctx.FormFile("metadata"); err == nil {
+		metadataFile, err := metadataHeader.Open()
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer metadataFile.Close()
+		metadata, metadataName = metadataFile, metadataHeader.Filename
+	}
+
+	job, err := c.bulkImportJobService.RequestImport(ctx.GetUint("userID"), archiveFile, archiveHeader.Size, metadata, metadataName)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "일괄 가져오기 작업을 요청했습니다", "job": job})
+}
+
+// GetImportJob 일괄 가져오기 작업 조회 (관리자), 완료된 작업은 결과 파일 URL 포함
+func (c *BulkImportController) GetImportJob(ctx *gin.Context) {
+	jobID, err := c.parseAndValidateID(ctx.Param("jobID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	job, err := c.bulkImportJobService.GetImportJob(jobID)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"job": job})
+}
+
+// errorStatus 일괄 가져오기 에러를 HTTP 상태 코드로 변환
+func (c *BulkImportController) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrInvalidBulkImportArchive), errors.Is(err, services.ErrInvalidBulkImportSidecar), errors.Is(err, services.ErrBulkImportSidecarMissing):
+		return http.StatusBadRequest
+	case errors.Is(err, services.ErrBulkImportJobNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseAndValidateID ID 파라미터 파싱 및 유효성 검사
+func (c *BulkImportController) parseAndValidateID(paramID string) (uint, error) {
+	return utils.ParseAndValidateID(paramID)
+}
@@ -1,10 +1,12 @@
 package controllers
 
 import (
+	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/zeze1004/image-hub-platform/services"
 	"github.com/zeze1004/image-hub-platform/utils"
 	"net/http"
+	"time"
 )
 
 type CategoryController struct {
@@ -22,7 +24,7 @@ func (c *CategoryController) GetCategoriesByImageID(ctx *gin.Context) {
 
 	categories, err := c.categoryService.GetCategoriesByImageIDAndUserID(imageID, ctx.GetUint("userID"), c.isAdmin(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 	ctx.JSON(http.StatusOK, categories)
@@ -37,6 +39,10 @@ func (c *CategoryController) AddCategoryToImage(ctx *gin.Context) {
 	imageID, _ := c.parseAndValidateID(imageIDParam)
 
 	if err := c.categoryService.AddCategoryToImageByImageIDAndCategoryID(imageID, categoryID, ctx.GetUint("userID"), c.isAdmin(ctx)); err != nil {
+		if errors.Is(err, services.ErrTooManyCategories) {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 		return
 	}
@@ -57,6 +63,96 @@ func (c *CategoryController) RemoveCategoryFromImage(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"message": "이미지에서 카테고리를 삭제했습니다"})
 }
 
+// BulkUpdateImageCategories 여러 이미지에 카테고리 일괄 추가/제거/교체
+func (c *CategoryController) BulkUpdateImageCategories(ctx *gin.Context) {
+	var bulkReq struct {
+		Action      string `json:"action" binding:"required"` // add, remove, set
+		ImageIDs    []uint `json:"image_ids" binding:"required"`
+		CategoryIDs []uint `json:"category_ids"`
+	}
+	if err := ctx.ShouldBindJSON(&bulkReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	results, err := c.categoryService.BulkUpdateImageCategories(
+		services.BulkCategoryAction(bulkReq.Action), bulkReq.ImageIDs, bulkReq.CategoryIDs, ctx.GetUint("userID"), c.isAdmin(ctx),
+	)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrInvalidBulkRequest) {
+			status = http.StatusBadRequest
+		} else if errors.Is(err, services.ErrTooManyCategories) {
+			status = http.StatusUnprocessableEntity
+		}
+		ctx.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// GetCategoryStats 카테고리 통계 조회 (?user_id=1&from=2024-01-01&to=2024-01-31)
+// from, to는 YYYY-MM-DD 형식이며 to 날짜까지 포함, 기본값은 오늘까지 최근 30일
+func (c *CategoryController) GetCategoryStats(ctx *gin.Context) {
+	var userID uint
+	if userIDParam := ctx.Query("user_id"); userIDParam != "" {
+		id, err := c.parseAndValidateID(userIDParam)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		userID = id
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	to, err := c.parseDate(ctx.Query("to"), today)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	from, err := c.parseDate(ctx.Query("from"), to.AddDate(0, 0, -29))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	stats, err := c.categoryService.GetCategoryStats(userID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrInvalidStatsPeriod) {
+			status = http.StatusBadRequest
+		}
+		ctx.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, stats)
+}
+
+// parseDate YYYY-MM-DD 형식의 날짜 파싱, 비어 있으면 기본값 반환
+func (c *CategoryController) parseDate(value string, defaultDate time.Time) (time.Time, error) {
+	if value == "" {
+		return defaultDate, nil
+	}
+	date, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return time.Time{}, errors.New("날짜는 YYYY-MM-DD 형식이어야 합니다")
+	}
+	return date, nil
+}
+
+// errorStatus 이미지 권한 검증 에러를 HTTP 상태 코드로 변환
+func (c *CategoryController) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, utils.ErrImageNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, utils.ErrImagePermissionDenied):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // isAdmin 관리자 권한인지 확인
 func (c *CategoryController) isAdmin(ctx *gin.Context) bool {
 	return utils.IsAdmin(ctx)
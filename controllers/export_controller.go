@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"net/http"
+)
+
+type ExportController struct {
+	exportService services.ExportService
+}
+
+func NewExportController(exportService services.ExportService) *ExportController {
+	return &ExportController{exportService: exportService}
+}
+
+// RequestExport 계정 데이터 내보내기 요청, 작업은 백그라운드에서 처리
+func (c *ExportController) RequestExport(ctx *gin.Context) {
+	userID := ctx.GetUint("userID")
+
+	var exportReq struct {
+		IncludeRenditions bool `json:"include_renditions"` // 썸네일 포함 여부
+	}
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&exportReq); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	job, err := c.exportService.RequestExport(userID, exportReq.IncludeRenditions)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusAccepted, gin.H{"message": "내보내기 작업을 요청했습니다", "job": job})
+}
+
+// GetExportJobs 내보내기 작업 목록 조회
+func (c *ExportController) GetExportJobs(ctx *gin.Context) {
+	userID := ctx.GetUint("userID")
+
+	jobs, err := c.exportService.GetExportJobs(userID)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"jobs": jobs})
+}
+
+// GetExportJob 내보내기 작업 조회, 완료된 작업은 다운로드 URL 포함
+func (c *ExportController) GetExportJob(ctx *gin.Context) {
+	userID := ctx.GetUint("userID")
+	jobID, err := c.parseAndValidateID(ctx.Param("jobID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	job, err := c.exportService.GetExportJob(jobID, userID)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"job": job})
+}
+
+// errorStatus 내보내기 에러를 HTTP 상태 코드로 변환
+func (c *ExportController) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrExportNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrExportInProgress):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseAndValidateID ID 파라미터 파싱 및 유효성 검사
+func (c *ExportController) parseAndValidateID(paramID string) (uint, error) {
+	return utils.ParseAndValidateID(paramID)
+}
@@ -0,0 +1,40 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"net/http"
+	"time"
+)
+
+type FileController struct {
+	storage storage.Storage
+	signer  *signedurl.Signer
+}
+
+func NewFileController(storage storage.Storage, signer *signedurl.Signer) *FileController {
+	return &FileController{storage: storage, signer: signer}
+}
+
+// ServeSignedFile 서명된 URL을 DB 조회 없이 검증하고 파일 반환
+func (c *FileController) ServeSignedFile(ctx *gin.Context) {
+	urlPath := signedurl.PathPrefix + ctx.Param("filepath")
+
+	expiresAt, err := c.signer.Verify(urlPath, ctx.Query("expires"), ctx.Query("signature"))
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	storagePath, err := c.signer.StoragePath(urlPath)
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	// URL이 만료될 때까지 브라우저가 캐시하도록 함
+	maxAge := int(time.Until(expiresAt).Seconds())
+	utils.ServeImageFile(ctx, c.storage, storagePath, "", time.Time{}, fmt.Sprintf("private, max-age=%d", maxAge), "")
+}
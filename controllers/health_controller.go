@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/version"
+	"net/http"
+)
+
+type HealthController struct {
+	healthService services.HealthService
+}
+
+func NewHealthController(healthService services.HealthService) *HealthController {
+	return &HealthController{healthService: healthService}
+}
+
+// Healthz 프로세스가 살아 있는지 확인, 의존하는 외부 자원은 확인하지 않음
+func (c *HealthController) Healthz(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readyz 요청을 받을 준비가 됐는지 확인, 준비되지 않았으면 503과 실패한 항목 반환
+func (c *HealthController) Readyz(ctx *gin.Context) {
+	readiness := c.healthService.Readiness(ctx.Request.Context())
+	if !readiness.Ready {
+		ctx.JSON(http.StatusServiceUnavailable, readiness)
+		return
+	}
+	ctx.JSON(http.StatusOK, readiness)
+}
+
+// Version 빌드한 커밋과 시각
+func (c *HealthController) Version(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, version.Get())
+}
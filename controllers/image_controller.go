@@ -1,18 +1,29 @@
 package controllers
 
 import (
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/models"
 	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"github.com/zeze1004/image-hub-platform/storage"
 	"github.com/zeze1004/image-hub-platform/utils"
+	"io"
+	"log"
+	"mime/multipart"
 	"net/http"
+	"strings"
 )
 
 type ImageController struct {
 	imageService services.ImageService
+	storage      storage.Storage
+	signer       *signedurl.Signer
 }
 
-func NewImageController(imageService services.ImageService) *ImageController {
-	return &ImageController{imageService: imageService}
+func NewImageController(imageService services.ImageService, storage storage.Storage, signer *signedurl.Signer) *ImageController {
+	return &ImageController{imageService: imageService, storage: storage, signer: signer}
 }
 
 func (c *ImageController) UploadImage(ctx *gin.Context) {
@@ -26,22 +37,101 @@ func (c *ImageController) UploadImage(ctx *gin.Context) {
 	}
 
 	// 이미지 파일, 설명, 카테고리 가져오기
-	file, err := ctx.FormFile("image")
-	if err != nil {
+	form, err := ctx.MultipartForm()
+	if err != nil || len(form.File["image"]) == 0 {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "이미지 파일이 누락됐습니다"})
 		return
 	}
+	file := form.File["image"][0]
 	description := ctx.PostForm("description")
 	categoryNames := ctx.PostFormArray("categories")
 
+	// 카테고리 매칭 모드: strict는 존재하지 않는 카테고리가 있으면 업로드 거절, lenient(기본값)는 경고와 함께 업로드
+	var strictCategories bool
+	switch ctx.DefaultPostForm("category_mode", "lenient") {
+	case "strict":
+		strictCategories = true
+	case "lenient":
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "category_mode는 strict 또는 lenient만 가능합니다"})
+		return
+	}
+
+	// image 파트가 여러 개면 파일별로 업로드하고 파일별 결과 반환
+	if len(form.File["image"]) > 1 {
+		c.uploadImages(ctx, form.File["image"], userID, strictCategories)
+		return
+	}
+
 	// 이미지 업로드
-	image, err := c.imageService.UploadImage(ctx, file.Filename, description, userID, categoryNames)
+	image, unknownCategories, err := c.imageService.UploadImage(ctx, file.Filename, description, userID, categoryNames, strictCategories)
 	if err != nil {
+		var unknownErr *services.UnknownCategoriesError
+		if errors.As(err, &unknownErr) {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "invalid_categories": unknownErr.Names})
+			return
+		}
+		if errors.Is(err, services.ErrTooManyCategories) {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{"message": "이미지 업로드가 성공했습니다", "image": image})
+	utils.SignImageURL(c.signer, image)
+	response := gin.H{"message": "이미지 업로드가 성공했습니다", "image": image}
+	if len(unknownCategories) > 0 {
+		response["warnings"] = []string{"존재하지 않는 카테고리는 제외됐습니다: " + strings.Join(unknownCategories, ", ")}
+		response["invalid_categories"] = unknownCategories
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// uploadImages 여러 이미지 업로드
+// description을 파일 수만큼 보내면 순서대로 각 파일의 설명이 되고, 하나만 보내면 모든 파일에 적용
+// categories는 모든 파일에 적용되며, categories[i]를 보내면 i번째 파일은 해당 카테고리로 업로드
+func (c *ImageController) uploadImages(ctx *gin.Context, fileHeaders []*multipart.FileHeader, userID uint, strictCategories bool) {
+	descriptions := ctx.PostFormArray("description")
+	categoryNames := ctx.PostFormArray("categories")
+
+	files := make([]services.BatchUploadFile, len(fileHeaders))
+	for i, fileHeader := range fileHeaders {
+		files[i] = services.BatchUploadFile{
+			FileName:      fileHeader.Filename,
+			CategoryNames: categoryNames,
+			Open: func() (io.ReadCloser, error) {
+				return fileHeader.Open()
+			},
+		}
+		if len(descriptions) == len(fileHeaders) {
+			files[i].Description = descriptions[i]
+		} else if len(descriptions) == 1 {
+			files[i].Description = descriptions[0]
+		}
+		if fileCategoryNames, ok := ctx.GetPostFormArray(fmt.Sprintf("categories[%d]", i)); ok {
+			files[i].CategoryNames = fileCategoryNames
+		}
+	}
+
+	results, err := c.imageService.UploadImages(files, userID, strictCategories)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrInvalidBatchUpload) {
+			status = http.StatusBadRequest
+		}
+		ctx.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+
+	var succeeded int
+	for _, result := range results {
+		if result.Success {
+			succeeded++
+		}
+		utils.SignImageURL(c.signer, result.Image)
+	}
+	ctx.JSON(http.StatusOK, gin.H{"results": results, "succeeded": succeeded, "failed": len(results) - succeeded})
 }
 
 // GetThumbnail - 이미지 ID를 받아 썸네일 이미지 파일을 반환
@@ -49,13 +139,37 @@ func (c *ImageController) GetThumbnail(ctx *gin.Context) {
 	imageIDParam := ctx.Param("imageID")
 	imageID, _ := c.parseAndValidateID(imageIDParam)
 
-	thumbnailPath, err := c.imageService.GetThumbnail(imageID)
+	image, err := c.imageService.GetThumbnail(imageID, ctx.GetUint("userID"), c.isAdmin(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
-	ctx.File(thumbnailPath) // 썸네일 이미지 파일 반환
+	// 썸네일은 원본에서 만들어지므로 원본 해시에 렌디션 이름을 붙여 ETag로 사용
+	etag := c.contentHash(image)
+	if etag != "" {
+		etag += "-thumb"
+	}
+	utils.ServeImageFile(ctx, c.storage, image.ThumbnailPath, etag, image.UpdatedAt, utils.CacheControlThumbnail, "") // 썸네일 이미지 파일 반환
+}
+
+// GetImageFile 원본 이미지 파일 반환, ?download=1이면 원본 파일명으로 다운로드
+func (c *ImageController) GetImageFile(ctx *gin.Context) {
+	imageIDParam := ctx.Param("imageID")
+	imageID, _ := c.parseAndValidateID(imageIDParam)
+
+	image, err := c.imageService.GetImageByID(imageID, ctx.GetUint("userID"), c.isAdmin(ctx))
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	var downloadName string
+	if ctx.Query("download") == "1" {
+		downloadName = image.FileName
+	}
+	etag := c.contentHash(image)
+	utils.ServeImageFile(ctx, c.storage, image.FilePath, etag, image.UpdatedAt, utils.CacheControlOriginal, downloadName)
 }
 
 // GetImagesByUserID User가 가진 모든 이미지 목록 조회
@@ -68,6 +182,7 @@ func (c *ImageController) GetImagesByUserID(ctx *gin.Context) {
 		userID = ctx.GetUint("userID")
 	}
 	images, _ := c.imageService.GetImagesByUserID(userID)
+	utils.SignImageURLs(c.signer, images)
 	ctx.JSON(http.StatusOK, images)
 }
 
@@ -78,9 +193,10 @@ func (c *ImageController) GetImageByID(ctx *gin.Context) {
 
 	image, err := c.imageService.GetImageByID(imageID, ctx.GetUint("userID"), c.isAdmin(ctx))
 	if err != nil {
-		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
+	utils.SignImageURL(c.signer, image)
 	ctx.JSON(http.StatusOK, image)
 }
 
@@ -91,6 +207,7 @@ func (c *ImageController) GetAllImagesByAdmin(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	utils.SignImageURLs(c.signer, images)
 	ctx.JSON(http.StatusOK, images)
 
 }
@@ -134,9 +251,32 @@ func (c *ImageController) GetImagesByCategoryID(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	utils.SignImageURLs(c.signer, images)
 	ctx.JSON(http.StatusOK, images)
 }
 
+// contentHash ETag로 사용할 원본 해시, 해시를 구하지 못하면 ETag 없이 응답
+func (c *ImageController) contentHash(image *models.Image) string {
+	hash, err := c.imageService.GetContentHash(image)
+	if err != nil {
+		log.Printf("이미지(%d)의 원본 해시를 구하지 못했습니다: %v", image.ID, err)
+		return ""
+	}
+	return hash
+}
+
+// errorStatus 이미지 조회 에러를 HTTP 상태 코드로 변환
+func (c *ImageController) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, utils.ErrImageNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, utils.ErrImagePermissionDenied):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // isAdmin 관리자 권한인지 확인
 func (c *ImageController) isAdmin(ctx *gin.Context) bool {
 	return utils.IsAdmin(ctx)
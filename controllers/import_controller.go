@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"net/http"
+	"strings"
+)
+
+type ImportController struct {
+	importService services.ImportService
+	signer        *signedurl.Signer
+}
+
+func NewImportController(importService services.ImportService, signer *signedurl.Signer) *ImportController {
+	return &ImportController{importService: importService, signer: signer}
+}
+
+// ImportImageFromURL URL의 이미지를 가져와서 업로드
+func (c *ImportController) ImportImageFromURL(ctx *gin.Context) {
+	var userID uint
+	if c.isAdmin(ctx) {
+		id, err := c.parseAndValidateID(ctx.Param("userID"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		userID = id
+	} else {
+		userID = ctx.GetUint("userID")
+	}
+
+	var importReq struct {
+		URL          string   `json:"url" binding:"required"`
+		Description  string   `json:"description"`
+		Categories   []string `json:"categories"`
+		CategoryMode string   `json:"category_mode"` // strict, lenient(기본값)
+	}
+	if err := ctx.ShouldBindJSON(&importReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var strictCategories bool
+	switch importReq.CategoryMode {
+	case "strict":
+		strictCategories = true
+	case "", "lenient":
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "category_mode는 strict 또는 lenient만 가능합니다"})
+		return
+	}
+
+	image, unknownCategories, err := c.importService.ImportImageFromURL(ctx.Request.Context(), importReq.URL, importReq.Description, userID, importReq.Categories, strictCategories)
+	if err != nil {
+		var unknownErr *services.UnknownCategoriesError
+		if errors.As(err, &unknownErr) {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "invalid_categories": unknownErr.Names})
+			return
+		}
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	utils.SignImageURL(c.signer, image)
+	response := gin.H{"message": "이미지를 가져왔습니다", "image": image}
+	if len(unknownCategories) > 0 {
+		response["warnings"] = []string{"존재하지 않는 카테고리는 제외됐습니다: " + strings.Join(unknownCategories, ", ")}
+		response["invalid_categories"] = unknownCategories
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// errorStatus 이미지 가져오기 에러를 HTTP 상태 코드로 변환
+func (c *ImportController) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrInvalidImportURL):
+		return http.StatusBadRequest
+	case errors.Is(err, services.ErrImportBlockedHost):
+		return http.StatusForbidden
+	case errors.Is(err, services.ErrImportTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, services.ErrImportNotImage), errors.Is(err, services.ErrTooManyCategories):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, services.ErrImportFailed):
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// isAdmin 관리자 권한인지 확인
+func (c *ImportController) isAdmin(ctx *gin.Context) bool {
+	return utils.IsAdmin(ctx)
+}
+
+// parseAndValidateID ID 파라미터 파싱 및 유효성 검사
+func (c *ImportController) parseAndValidateID(paramID string) (uint, error) {
+	return utils.ParseAndValidateID(paramID)
+}
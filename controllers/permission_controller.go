@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"net/http"
+)
+
+type PermissionController struct {
+	permissionService services.PermissionService
+	signer            *signedurl.Signer
+}
+
+func NewPermissionController(permissionService services.PermissionService, signer *signedurl.Signer) *PermissionController {
+	return &PermissionController{permissionService: permissionService, signer: signer}
+}
+
+// GrantPermission 다른 사용자에게 이미지 권한 부여
+func (c *PermissionController) GrantPermission(ctx *gin.Context) {
+	imageID, err := c.parseAndValidateID(ctx.Param("imageID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var permissionReq struct {
+		Email string `json:"email" binding:"required"`
+		Role  string `json:"role" binding:"required"` // VIEWER, EDITOR
+	}
+	if err := ctx.ShouldBindJSON(&permissionReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	permission, err := c.permissionService.GrantPermission(imageID, ctx.GetUint("userID"), c.isAdmin(ctx), permissionReq.Email, permissionReq.Role)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "이미지 권한이 부여됐습니다", "permission": permission})
+}
+
+// GetPermissions 이미지에 부여된 권한 목록 조회
+func (c *PermissionController) GetPermissions(ctx *gin.Context) {
+	imageID, err := c.parseAndValidateID(ctx.Param("imageID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	permissions, err := c.permissionService.GetPermissionsByImageID(imageID, ctx.GetUint("userID"), c.isAdmin(ctx))
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, permissions)
+}
+
+// RevokePermission 경로 파라미터 userID 사용자의 이미지 권한 회수
+func (c *PermissionController) RevokePermission(ctx *gin.Context) {
+	imageID, err := c.parseAndValidateID(ctx.Param("imageID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	granteeID, err := c.parseAndValidateID(ctx.Param("userID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.permissionService.RevokePermission(imageID, granteeID, ctx.GetUint("userID"), c.isAdmin(ctx)); err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "이미지 권한이 회수됐습니다"})
+}
+
+// GetSharedImages 다른 사용자에게 공유받은 이미지 목록 조회
+func (c *PermissionController) GetSharedImages(ctx *gin.Context) {
+	images, err := c.permissionService.GetImagesSharedWithUser(ctx.GetUint("userID"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	for i := range images {
+		utils.SignImageURL(c.signer, &images[i].Image)
+	}
+	ctx.JSON(http.StatusOK, images)
+}
+
+// errorStatus 권한 서비스 에러를 HTTP 상태 코드로 변환
+func (c *PermissionController) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrInvalidPermissionRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, services.ErrPermissionNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusForbidden
+	}
+}
+
+// isAdmin 관리자 권한인지 확인
+func (c *PermissionController) isAdmin(ctx *gin.Context) bool {
+	return utils.IsAdmin(ctx)
+}
+
+// parseAndValidateID ID 파라미터 파싱 및 유효성 검사
+func (c *PermissionController) parseAndValidateID(paramID string) (uint, error) {
+	return utils.ParseAndValidateID(paramID)
+}
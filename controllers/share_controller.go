@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"net/http"
+	"time"
+)
+
+type ShareController struct {
+	shareService services.ShareService
+	storage      storage.Storage
+}
+
+func NewShareController(shareService services.ShareService, storage storage.Storage) *ShareController {
+	return &ShareController{shareService: shareService, storage: storage}
+}
+
+// CreateShare 이미지 공유 링크 생성
+func (c *ShareController) CreateShare(ctx *gin.Context) {
+	imageID, err := c.parseAndValidateID(ctx.Param("imageID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var shareReq struct {
+		ExpiresAt *time.Time `json:"expires_at"` // RFC3339, 없으면 만료되지 않음
+		Password  string     `json:"password"`
+		MaxViews  int        `json:"max_views"` // 0이면 제한 없음
+	}
+	if err := ctx.ShouldBindJSON(&shareReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	share, token, err := c.shareService.CreateShare(imageID, ctx.GetUint("userID"), c.isAdmin(ctx), services.ShareOptions{
+		ExpiresAt: shareReq.ExpiresAt,
+		Password:  shareReq.Password,
+		MaxViews:  shareReq.MaxViews,
+	})
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "공유 링크가 생성됐습니다",
+		"share":   share,
+		"token":   token,
+		"url":     "/s/" + token,
+	})
+}
+
+// GetShares 이미지의 공유 링크 목록 조회
+func (c *ShareController) GetShares(ctx *gin.Context) {
+	imageID, err := c.parseAndValidateID(ctx.Param("imageID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	shares, err := c.shareService.GetSharesByImageID(imageID, ctx.GetUint("userID"), c.isAdmin(ctx))
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, shares)
+}
+
+// RevokeShare 공유 링크 폐기
+func (c *ShareController) RevokeShare(ctx *gin.Context) {
+	imageID, err := c.parseAndValidateID(ctx.Param("imageID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	shareID, err := c.parseAndValidateID(ctx.Param("shareID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.shareService.RevokeShare(imageID, shareID, ctx.GetUint("userID"), c.isAdmin(ctx)); err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "공유 링크가 폐기됐습니다"})
+}
+
+// OpenShare 인증 없이 공유 링크로 원본 이미지 반환, 열 때마다 조회 수가 올라감
+func (c *ShareController) OpenShare(ctx *gin.Context) {
+	password, ok := c.sharePassword(ctx)
+	if !ok {
+		return
+	}
+	image, err := c.shareService.OpenShare(ctx.Param("token"), password)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.serveShareFile(ctx, image.FilePath)
+}
+
+// OpenShareThumbnail 인증 없이 공유 링크로 썸네일 이미지 반환, 조회 수는 올라가지 않음
+func (c *ShareController) OpenShareThumbnail(ctx *gin.Context) {
+	password, ok := c.sharePassword(ctx)
+	if !ok {
+		return
+	}
+	image, err := c.shareService.OpenShareThumbnail(ctx.Param("token"), password)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.serveShareFile(ctx, image.ThumbnailPath)
+}
+
+// sharePassword 비밀번호는 X-Share-Password 헤더나 POST 본문(JSON, 폼)의 password로 전달
+// 쿼리 문자열은 접근 로그와 브라우저 기록에 남으므로 받지 않음
+func (c *ShareController) sharePassword(ctx *gin.Context) (string, bool) {
+	if password := ctx.GetHeader("X-Share-Password"); password != "" || ctx.Request.Method != http.MethodPost {
+		return password, true
+	}
+
+	var passwordReq struct {
+		Password string `json:"password" form:"password"`
+	}
+	if err := ctx.ShouldBind(&passwordReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return "", false
+	}
+	return passwordReq.Password, true
+}
+
+// serveShareFile 공유된 이미지 파일을 저장소에서 읽어서 반환
+func (c *ShareController) serveShareFile(ctx *gin.Context, filePath string) {
+	// 공유 토큰이 Referer 헤더로 다른 사이트에 전달되지 않도록 함
+	ctx.Header("Referrer-Policy", "no-referrer")
+	utils.ServeImageFile(ctx, c.storage, filePath, "", time.Time{}, utils.CacheControlShare, "")
+}
+
+// errorStatus 공유 서비스 에러를 HTTP 상태 코드로 변환
+func (c *ShareController) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrInvalidShareRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, services.ErrSharePasswordRequired):
+		return http.StatusUnauthorized
+	case errors.Is(err, services.ErrShareNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrShareExpired):
+		return http.StatusGone
+	default:
+		return http.StatusForbidden
+	}
+}
+
+// isAdmin 관리자 권한인지 확인
+func (c *ShareController) isAdmin(ctx *gin.Context) bool {
+	return utils.IsAdmin(ctx)
+}
+
+// parseAndValidateID ID 파라미터 파싱 및 유효성 검사
+func (c *ShareController) parseAndValidateID(paramID string) (uint, error) {
+	return utils.ParseAndValidateID(paramID)
+}
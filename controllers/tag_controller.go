@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"net/http"
+	"strconv"
+)
+
+type TagController struct {
+	tagService services.TagService
+	signer     *signedurl.Signer
+}
+
+func NewTagController(tagService services.TagService, signer *signedurl.Signer) *TagController {
+	return &TagController{tagService: tagService, signer: signer}
+}
+
+// GetTagsByImageID 특정 이미지의 태그 조회
+func (c *TagController) GetTagsByImageID(ctx *gin.Context) {
+	imageID, err := c.parseAndValidateID(ctx.Param("imageID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tags, err := c.tagService.GetTagsByImageID(imageID, ctx.GetUint("userID"), c.isAdmin(ctx))
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, tags)
+}
+
+// AddTagsToImage 이미지에 태그 추가
+func (c *TagController) AddTagsToImage(ctx *gin.Context) {
+	imageID, err := c.parseAndValidateID(ctx.Param("imageID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var tagReq struct {
+		Tags []string `json:"tags" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&tagReq); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tags, err := c.tagService.AddTagsToImage(imageID, ctx.GetUint("userID"), c.isAdmin(ctx), tagReq.Tags)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "이미지에 태그가 추가됐습니다", "tags": tags})
+}
+
+// RemoveTagFromImage 이미지에서 태그 삭제
+func (c *TagController) RemoveTagFromImage(ctx *gin.Context) {
+	imageID, err := c.parseAndValidateID(ctx.Param("imageID"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := c.tagService.RemoveTagFromImage(imageID, ctx.GetUint("userID"), c.isAdmin(ctx), ctx.Param("tagName")); err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "이미지에서 태그를 삭제했습니다"})
+}
+
+// SearchTags 접두어로 태그 자동완성 (?prefix=ca&limit=10)
+func (c *TagController) SearchTags(ctx *gin.Context) {
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+
+	tags, err := c.tagService.SearchTagsByPrefix(ctx.Query("prefix"), ctx.GetUint("userID"), c.isAdmin(ctx), limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, tags)
+}
+
+// GetImagesByTagName 특정 태그가 붙은 이미지 조회
+func (c *TagController) GetImagesByTagName(ctx *gin.Context) {
+	images, err := c.tagService.GetImagesByTagName(ctx.Param("tagName"), ctx.GetUint("userID"), c.isAdmin(ctx))
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	utils.SignImageURLs(c.signer, images)
+	ctx.JSON(http.StatusOK, images)
+}
+
+// errorStatus 태그 서비스 에러를 HTTP 상태 코드로 변환
+func (c *TagController) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrInvalidTagName), errors.Is(err, services.ErrTooManyTags):
+		return http.StatusBadRequest
+	case errors.Is(err, services.ErrTagNotFound), errors.Is(err, utils.ErrImageNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, utils.ErrImagePermissionDenied):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// isAdmin 관리자 권한인지 확인
+func (c *TagController) isAdmin(ctx *gin.Context) bool {
+	return utils.IsAdmin(ctx)
+}
+
+// parseAndValidateID ID 파라미터 파싱 및 유효성 검사
+func (c *TagController) parseAndValidateID(paramID string) (uint, error) {
+	return utils.ParseAndValidateID(paramID)
+}
@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"encoding/base64"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/middlewares"
+	"github.com/zeze1004/image-hub-platform/services"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// UploadController tus 프로토콜(https://tus.io/protocols/resumable-upload) 이어올리기 업로드
+// creation, expiration, termination 확장 지원
+type UploadController struct {
+	uploadService services.UploadService
+}
+
+func NewUploadController(uploadService services.UploadService) *UploadController {
+	return &UploadController{uploadService: uploadService}
+}
+
+// GetUploadOptions 서버가 지원하는 tus 버전, 확장, 최대 파일 크기 반환
+func (c *UploadController) GetUploadOptions(ctx *gin.Context) {
+	ctx.Header("Tus-Version", middlewares.TusVersion)
+	ctx.Header("Tus-Extension", "creation,expiration,termination")
+	ctx.Header("Tus-Max-Size", strconv.FormatInt(services.MaxUploadLength, 10))
+	ctx.Status(http.StatusNoContent)
+}
+
+// CreateUpload 이어올리기 업로드 생성
+// Upload-Metadata 헤더로 filename(필수), description, categories(쉼표로 구분), category_mode를 받음
+func (c *UploadController) CreateUpload(ctx *gin.Context) {
+	length, err := strconv.ParseInt(ctx.GetHeader("Upload-Length"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Upload-Length 헤더가 잘못됐습니다"})
+		return
+	}
+	metadata, err := parseUploadMetadata(ctx.GetHeader("Upload-Metadata"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	uploadMetadata := services.UploadMetadata{
+		FileName:    metadata["filename"],
+		Description: metadata["description"],
+	}
+	for _, name := range strings.Split(metadata["categories"], ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			uploadMetadata.Categories = append(uploadMetadata.Categories, name)
+		}
+	}
+	switch metadata["category_mode"] {
+	case "strict":
+		uploadMetadata.StrictCategories = true
+	case "", "lenient":
+	default:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "category_mode는 strict 또는 lenient만 가능합니다"})
+		return
+	}
+
+	upload, err := c.uploadService.CreateUpload(ctx.GetUint("userID"), length, uploadMetadata)
+	if err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.Header("Location", strings.TrimSuffix(ctx.Request.URL.Path, "/")+"/"+upload.ID)
+	ctx.Header("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+	ctx.Status(http.StatusCreated)
+}
+
+// GetUploadOffset 지금까지 업로드된 크기 반환, 클라이언트는 이 위치부터 이어서 업로드
+func (c *UploadController) GetUploadOffset(ctx *gin.Context) {
+	upload, err := c.uploadService.GetUpload(ctx.Param("uploadID"), ctx.GetUint("userID"))
+	if err != nil {
+		ctx.Status(c.errorStatus(err)) // HEAD 요청이므로 본문 없이 응답
+		return
+	}
+	ctx.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	ctx.Header("Upload-Length", strconv.FormatInt(upload.Length, 10))
+	ctx.Header("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Status(http.StatusOK)
+}
+
+// WriteUploadChunk Upload-Offset 위치부터 요청 본문을 이어쓰기
+// 업로드가 완료되면 생성된 이미지 ID를 X-Image-ID 헤더로 반환
+func (c *UploadController) WriteUploadChunk(ctx *gin.Context) {
+	if ctx.ContentType() != "application/offset+octet-stream" {
+		ctx.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type은 application/offset+octet-stream만 가능합니다"})
+		return
+	}
+	offset, err := strconv.ParseInt(ctx.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil || offset < 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Upload-Offset 헤더가 잘못됐습니다"})
+		return
+	}
+
+	upload, image, unknownCategories, err := c.uploadService.WriteChunk(ctx.Param("uploadID"), ctx.GetUint("userID"), offset, ctx.Request.Body)
+	if err != nil {
+		var unknownErr *services.UnknownCategoriesError
+		if errors.As(err, &unknownErr) {
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error(), "invalid_categories": unknownErr.Names})
+			return
+		}
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	if image != nil {
+		ctx.Header("X-Image-ID", strconv.FormatUint(uint64(image.ID), 10))
+		if len(unknownCategories) > 0 {
+			ctx.Header("X-Invalid-Categories", strings.Join(unknownCategories, ","))
+		}
+	} else {
+		ctx.Header("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// DeleteUpload 업로드 취소
+func (c *UploadController) DeleteUpload(ctx *gin.Context) {
+	if err := c.uploadService.DeleteUpload(ctx.Param("uploadID"), ctx.GetUint("userID")); err != nil {
+		ctx.JSON(c.errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// errorStatus 업로드 서비스 에러를 HTTP 상태 코드로 변환
+func (c *UploadController) errorStatus(err error) int {
+	switch {
+	case errors.Is(err, services.ErrInvalidUploadRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, services.ErrUploadNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, services.ErrUploadExpired):
+		return http.StatusGone
+	case errors.Is(err, services.ErrUploadOffsetMismatch):
+		return http.StatusConflict
+	case errors.Is(err, services.ErrUploadTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, services.ErrTooManyCategories):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseUploadMetadata "key base64값,key base64값" 형식의 Upload-Metadata 헤더 파싱
+func parseUploadMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, encoded, _ := strings.Cut(pair, " ")
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.New("Upload-Metadata 헤더가 잘못됐습니다")
+		}
+		metadata[key] = string(value)
+	}
+	return metadata, nil
+}
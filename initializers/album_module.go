@@ -0,0 +1,17 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"gorm.io/gorm"
+)
+
+func InitAlbumModule(db *gorm.DB, signer *signedurl.Signer) *controllers.AlbumController {
+	imageRepo := repositories.NewImageRepository(db)
+	albumRepo := repositories.NewAlbumRepository(db)
+	albumService := services.NewAlbumService(imageRepo, albumRepo)
+	albumController := controllers.NewAlbumController(albumService, signer)
+	return albumController
+}
@@ -0,0 +1,18 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"gorm.io/gorm"
+)
+
+func InitArchiveModule(db *gorm.DB) *controllers.ArchiveController {
+	imageRepo := repositories.NewImageRepository(db)
+	categoryRepo := repositories.NewCategoryRepository(db)
+	albumRepo := repositories.NewAlbumRepository(db)
+	permissionRepo := repositories.NewPermissionRepository(db)
+	archiveService := services.NewArchiveService(imageRepo, categoryRepo, albumRepo, permissionRepo, InitStorage())
+	archiveController := controllers.NewArchiveController(archiveService)
+	return archiveController
+}
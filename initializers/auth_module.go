@@ -4,12 +4,13 @@ import (
 	"github.com/zeze1004/image-hub-platform/controllers"
 	"github.com/zeze1004/image-hub-platform/repositories"
 	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/utils"
 	"gorm.io/gorm"
 )
 
-func InitUserModule(db *gorm.DB) *controllers.AuthController {
+func InitUserModule(db *gorm.DB, jwt *utils.JWT) *controllers.AuthController {
 	userRepo := repositories.NewUserRepository(db)
-	authService := services.NewAuthService(userRepo)
+	authService := services.NewAuthService(userRepo, jwt)
 	authController := controllers.NewAuthController(authService)
 	return authController
 }
@@ -0,0 +1,49 @@
+package initializers
+
+import (
+	"context"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"gorm.io/gorm"
+	"log"
+	"time"
+)
+
+const bulkImportPurgeInterval = time.Hour // 보관 기간이 지난 일괄 가져오기 결과 파일 정리 주기
+
+func InitBulkImportModule(db *gorm.DB, cfg *config.Config, signer *signedurl.Signer, background *lifecycle.Registry) *controllers.BulkImportController {
+	bulkImportRepo := repositories.NewBulkImportRepository(db)
+	bulkImportJobService := services.NewBulkImportJobService(bulkImportRepo, InitBulkImportService(db, cfg), InitStorage(), signer, cfg.Storage)
+	bulkImportController := controllers.NewBulkImportController(bulkImportJobService)
+
+	// 대기 중인 일괄 가져오기 작업을 처리하는 작업자, 종료할 때는 처리 중인 작업을 멈추고 다음 실행에서 다시 처리
+	background.Go("일괄 가져오기 작업자", bulkImportJobService.Run)
+
+	// 보관 기간이 지난 결과 파일을 주기적으로 정리
+	background.Every("만료된 일괄 가져오기 결과 파일 정리", bulkImportPurgeInterval, func(context.Context) {
+		purged, err := bulkImportJobService.PurgeExpiredImports()
+		if err != nil {
+			log.Printf("만료된 일괄 가져오기 결과 파일을 정리하는데 실패했습니다: %v", err)
+		}
+		if purged > 0 {
+			log.Printf("만료된 일괄 가져오기 결과 파일 %d개를 정리했습니다", purged)
+		}
+	})
+
+	return bulkImportController
+}
+
+// InitBulkImportService 관리자 일괄 가져오기 작업과 cmd/import에서 함께 쓰는 일괄 가져오기 서비스 생성
+func InitBulkImportService(db *gorm.DB, cfg *config.Config) services.BulkImportService {
+	userRepo := repositories.NewUserRepository(db)
+	imageRepo := repositories.NewImageRepository(db)
+	categoryRepo := repositories.NewCategoryRepository(db)
+	imageCategoryRepo := repositories.NewImageCategoryRepository(db)
+	permissionRepo := repositories.NewPermissionRepository(db)
+	imageService := services.NewImageService(imageRepo, categoryRepo, imageCategoryRepo, permissionRepo, InitStorage(), cfg.Storage, cfg.Image, nil)
+	return services.NewBulkImportService(userRepo, imageRepo, imageService)
+}
@@ -11,7 +11,8 @@ func InitCategoryModule(db *gorm.DB) *controllers.CategoryController {
 	imageRepo := repositories.NewImageRepository(db)
 	categoryRepo := repositories.NewCategoryRepository(db)
 	imageCategoryRepo := repositories.NewImageCategoryRepository(db)
-	categoryService := services.NewCategoryService(imageRepo, categoryRepo, imageCategoryRepo)
+	permissionRepo := repositories.NewPermissionRepository(db)
+	categoryService := services.NewCategoryService(imageRepo, categoryRepo, imageCategoryRepo, permissionRepo)
 	categoryController := controllers.NewCategoryController(categoryService)
 	return categoryController
 }
@@ -0,0 +1,44 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/config"
+	"log"
+	"os"
+)
+
+const defaultConfigFile = "config.json" // CONFIG_FILE이 없을 때 있으면 읽는 설정 파일
+
+// InitConfig .env와 설정 파일을 읽어서 설정을 만들고 검증
+func InitConfig() *config.Config {
+	cfg, err := config.Load(configPath())
+	if err != nil {
+		log.Fatalf("설정을 불러오는데 실패했습니다: %v", err)
+	}
+	log.Printf("설정: %s", cfg)
+
+	return cfg
+}
+
+// InitDatabaseConfig .env와 설정 파일을 읽어서 DB 설정만 검증하고 반환, DB만 쓰는 cmd/migrate에서 사용
+func InitDatabaseConfig() config.DatabaseConfig {
+	cfg, err := config.LoadDatabase(configPath())
+	if err != nil {
+		log.Fatalf("설정을 불러오는데 실패했습니다: %v", err)
+	}
+	return cfg
+}
+
+// configPath .env를 읽고 설정 파일 경로 반환, CONFIG_FILE이 없고 기본 설정 파일도 없으면 빈 문자열
+func configPath() string {
+	if err := config.LoadDotEnv(".env"); err != nil {
+		log.Fatalf(".env 파일을 읽는데 실패했습니다: %v", err)
+	}
+
+	path := os.Getenv("CONFIG_FILE")
+	if path == "" {
+		if _, err := os.Stat(defaultConfigFile); err == nil {
+			path = defaultConfigFile
+		}
+	}
+	return path
+}
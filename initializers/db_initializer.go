@@ -1,16 +1,61 @@
 package initializers
 
 import (
+	"github.com/glebarez/sqlite"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/metrics"
+	"github.com/zeze1004/image-hub-platform/migrations"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"log"
 )
 
-func InitDB() *gorm.DB {
-	dsn := "root:@tcp(127.0.0.1:3306)/image_hub?charset=utf8&parseTime=True&loc=Local" // TODO: 환경변수로부터 DSN 정보를 가져오도록 수정
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+func InitDB(cfg config.DatabaseConfig) *gorm.DB {
+	db, err := OpenDB(cfg)
 	if err != nil {
 		log.Fatalf("DB 연결에 실패했습니다: %v", err)
 	}
+	// 스키마 마이그레이션만 적용하고, 기본 카테고리와 관리자 계정 시드 데이터는 cmd/migrate -seed로 적용
+	if cfg.AutoMigrate {
+		applied, err := migrations.NewMigrator(db, migrations.Schema()).Up()
+		for _, migration := range applied {
+			log.Printf("마이그레이션 %04d_%s를 적용했습니다", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
 	return db
 }
+
+// OpenDB 설정한 드라이버로 DB 연결
+func OpenDB(cfg config.DatabaseConfig) (*gorm.DB, error) {
+	var dialector gorm.Dialector
+	switch cfg.Driver {
+	case config.DriverPostgres:
+		dialector = postgres.Open(cfg.DSN())
+	case config.DriverSQLite:
+		dialector = sqlite.Open(cfg.DSN())
+	default:
+		dialector = mysql.Open(cfg.DSN())
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Driver == config.DriverSQLite {
+		// SQLite는 쓰기 잠금이 DB 전체에 걸리므로 연결 하나로 쓰기를 직렬화
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		sqlDB.SetMaxOpenConns(1)
+	}
+	// 모든 쿼리의 실행 시간을 /metrics로 기록
+	if err := db.Use(metrics.GormPlugin{}); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
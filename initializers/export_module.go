@@ -0,0 +1,45 @@
+package initializers
+
+import (
+	"context"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"gorm.io/gorm"
+	"log"
+	"time"
+)
+
+const exportPurgeInterval = time.Hour // 보관 기간이 지난 내보내기 파일 정리 주기
+
+func InitExportModule(db *gorm.DB, cfg *config.Config, signer *signedurl.Signer, background *lifecycle.Registry) *controllers.ExportController {
+	exportRepo := repositories.NewExportRepository(db)
+	userRepo := repositories.NewUserRepository(db)
+	imageRepo := repositories.NewImageRepository(db)
+	categoryRepo := repositories.NewCategoryRepository(db)
+	albumRepo := repositories.NewAlbumRepository(db)
+	permissionRepo := repositories.NewPermissionRepository(db)
+	fileStorage := InitStorage()
+	archiveService := services.NewArchiveService(imageRepo, categoryRepo, albumRepo, permissionRepo, fileStorage)
+	exportService := services.NewExportService(exportRepo, userRepo, imageRepo, archiveService, fileStorage, signer, services.NewLogExportNotifier(), cfg.Storage)
+	exportController := controllers.NewExportController(exportService)
+
+	// 대기 중인 내보내기 작업을 처리하는 작업자, 종료할 때는 처리 중인 작업까지만 마치고 나머지는 다음 실행에서 처리
+	background.Go("내보내기 작업자", exportService.Run)
+
+	// 보관 기간이 지난 내보내기 파일을 주기적으로 정리
+	background.Every("만료된 내보내기 파일 정리", exportPurgeInterval, func(context.Context) {
+		purged, err := exportService.PurgeExpiredExports()
+		if err != nil {
+			log.Printf("만료된 내보내기 파일을 정리하는데 실패했습니다: %v", err)
+		}
+		if purged > 0 {
+			log.Printf("만료된 내보내기 파일 %d개를 정리했습니다", purged)
+		}
+	})
+
+	return exportController
+}
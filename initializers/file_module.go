@@ -0,0 +1,10 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+)
+
+func InitFileModule(signer *signedurl.Signer) *controllers.FileController {
+	return controllers.NewFileController(InitStorage(), signer)
+}
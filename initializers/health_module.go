@@ -0,0 +1,17 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"gorm.io/gorm"
+)
+
+func InitHealthModule(db *gorm.DB, cfg *config.Config, background *lifecycle.Registry) *controllers.HealthController {
+	healthRepo := repositories.NewHealthRepository(db)
+	healthService := services.NewHealthService(healthRepo, InitStorage(), cfg.Storage, background)
+	healthController := controllers.NewHealthController(healthService)
+	return healthController
+}
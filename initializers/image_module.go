@@ -1,17 +1,22 @@
 package initializers
 
 import (
+	"github.com/zeze1004/image-hub-platform/config"
 	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
 	"github.com/zeze1004/image-hub-platform/repositories"
 	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
 	"gorm.io/gorm"
 )
 
-func InitImageModule(db *gorm.DB) *controllers.ImageController {
+func InitImageModule(db *gorm.DB, cfg *config.Config, signer *signedurl.Signer, background *lifecycle.Registry) *controllers.ImageController {
 	imageRepo := repositories.NewImageRepository(db)
 	categoryRepo := repositories.NewCategoryRepository(db)
 	imageCategoryRepo := repositories.NewImageCategoryRepository(db)
-	imageService := services.NewImageService(imageRepo, categoryRepo, imageCategoryRepo)
-	imageController := controllers.NewImageController(imageService)
+	permissionRepo := repositories.NewPermissionRepository(db)
+	fileStorage := InitStorage()
+	imageService := services.NewImageService(imageRepo, categoryRepo, imageCategoryRepo, permissionRepo, fileStorage, cfg.Storage, cfg.Image, background)
+	imageController := controllers.NewImageController(imageService, fileStorage, signer)
 	return imageController
 }
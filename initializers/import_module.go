@@ -0,0 +1,22 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"gorm.io/gorm"
+)
+
+func InitImportModule(db *gorm.DB, cfg *config.Config, signer *signedurl.Signer) *controllers.ImportController {
+	imageRepo := repositories.NewImageRepository(db)
+	categoryRepo := repositories.NewCategoryRepository(db)
+	imageCategoryRepo := repositories.NewImageCategoryRepository(db)
+	permissionRepo := repositories.NewPermissionRepository(db)
+	// 가져오기는 파일을 삭제하지 않으므로 종료할 때 기다릴 백그라운드 작업이 없음
+	imageService := services.NewImageService(imageRepo, categoryRepo, imageCategoryRepo, permissionRepo, InitStorage(), cfg.Storage, cfg.Image, nil)
+	importService := services.NewImportService(imageService, cfg.Import.AllowedPrefixes()) // 허용하지 않은 내부 네트워크 주소는 차단
+	importController := controllers.NewImportController(importService, signer)
+	return importController
+}
@@ -0,0 +1,18 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"gorm.io/gorm"
+)
+
+func InitPermissionModule(db *gorm.DB, signer *signedurl.Signer) *controllers.PermissionController {
+	imageRepo := repositories.NewImageRepository(db)
+	userRepo := repositories.NewUserRepository(db)
+	permissionRepo := repositories.NewPermissionRepository(db)
+	permissionService := services.NewPermissionService(imageRepo, userRepo, permissionRepo)
+	permissionController := controllers.NewPermissionController(permissionService, signer)
+	return permissionController
+}
@@ -0,0 +1,16 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"gorm.io/gorm"
+)
+
+func InitShareModule(db *gorm.DB) *controllers.ShareController {
+	imageRepo := repositories.NewImageRepository(db)
+	shareRepo := repositories.NewShareRepository(db)
+	shareService := services.NewShareService(imageRepo, shareRepo)
+	shareController := controllers.NewShareController(shareService, InitStorage())
+	return shareController
+}
@@ -0,0 +1,18 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"time"
+)
+
+// InitJWT 설정의 JWT 서명 키와 유효 기간으로 토큰 발급, 검증에 쓰는 JWT 생성
+func InitJWT(cfg *config.Config) *utils.JWT {
+	return utils.NewJWT(cfg.Auth.JWTSecret, time.Duration(cfg.Auth.TokenTTL))
+}
+
+// InitURLSigner 설정의 URL 서명 키와 업로드 디렉토리로 파일 URL 서명에 쓰는 Signer 생성
+func InitURLSigner(cfg *config.Config) *signedurl.Signer {
+	return signedurl.NewSigner(cfg.Auth.URLSigningKey, cfg.Storage.UploadsDir)
+}
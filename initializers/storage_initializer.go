@@ -0,0 +1,10 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/storage"
+)
+
+// InitStorage 작업 시간을 메트릭으로 기록하는 로컬 디스크 저장소 생성
+func InitStorage() storage.Storage {
+	return storage.WithMetrics(storage.NewLocalStorage())
+}
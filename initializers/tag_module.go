@@ -0,0 +1,19 @@
+package initializers
+
+import (
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"gorm.io/gorm"
+)
+
+func InitTagModule(db *gorm.DB, signer *signedurl.Signer) *controllers.TagController {
+	imageRepo := repositories.NewImageRepository(db)
+	tagRepo := repositories.NewTagRepository(db)
+	imageTagRepo := repositories.NewImageTagRepository(db)
+	permissionRepo := repositories.NewPermissionRepository(db)
+	tagService := services.NewTagService(imageRepo, tagRepo, imageTagRepo, permissionRepo)
+	tagController := controllers.NewTagController(tagService, signer)
+	return tagController
+}
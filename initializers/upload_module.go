@@ -0,0 +1,40 @@
+package initializers
+
+import (
+	"context"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/controllers"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"gorm.io/gorm"
+	"log"
+	"time"
+)
+
+const uploadPurgeInterval = time.Hour // 만료된 이어올리기 업로드 정리 주기
+
+func InitUploadModule(db *gorm.DB, cfg *config.Config, background *lifecycle.Registry) *controllers.UploadController {
+	imageRepo := repositories.NewImageRepository(db)
+	categoryRepo := repositories.NewCategoryRepository(db)
+	imageCategoryRepo := repositories.NewImageCategoryRepository(db)
+	permissionRepo := repositories.NewPermissionRepository(db)
+	uploadRepo := repositories.NewUploadRepository(db)
+	fileStorage := InitStorage()
+	imageService := services.NewImageService(imageRepo, categoryRepo, imageCategoryRepo, permissionRepo, fileStorage, cfg.Storage, cfg.Image, background)
+	uploadService := services.NewUploadService(uploadRepo, imageService, fileStorage, cfg.Storage)
+	uploadController := controllers.NewUploadController(uploadService)
+
+	// 완료되지 않고 만료된 업로드를 주기적으로 정리
+	background.Every("만료된 업로드 정리", uploadPurgeInterval, func(context.Context) {
+		purged, err := uploadService.PurgeExpiredUploads()
+		if err != nil {
+			log.Printf("만료된 업로드를 정리하는데 실패했습니다: %v", err)
+		}
+		if purged > 0 {
+			log.Printf("만료된 업로드 %d개를 정리했습니다", purged)
+		}
+	})
+
+	return uploadController
+}
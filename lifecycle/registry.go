@@ -0,0 +1,133 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Registry 서버가 띄운 백그라운드 작업(파일 삭제, 내보내기 작업자, 주기적인 정리 작업 등)을 모아서
+// 종료할 때 작업이 끝나거나 다음 실행에서 이어갈 수 있는 상태로 정리될 때까지 기다림
+// nil Registry는 작업을 기다리지 않는 고루틴으로 실행 (cmd/import처럼 서버가 아닌 곳에서 서비스를 쓸 때)
+type Registry struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	closed  bool
+	running map[string]int // 작업 이름별로 실행 중인 개수, 종료가 늦어질 때 어떤 작업을 기다리는지 남기기 위해 사용
+}
+
+func NewRegistry() *Registry {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Registry{ctx: ctx, cancel: cancel, running: make(map[string]int)}
+}
+
+// Go fn을 고루틴으로 실행하고 Shutdown에서 끝날 때까지 기다림
+// fn이 받는 ctx는 Shutdown이 시작되면 취소되므로, 오래 걸리는 작업은 ctx를 보고 이어서 할 수 있는 지점에서 멈춰야 함
+// Stop이나 Shutdown으로 종료가 시작된 뒤에 등록한 작업은 호출한 고루틴에서 바로 실행
+func (r *Registry) Go(name string, fn func(ctx context.Context)) {
+	if r == nil {
+		go fn(context.Background())
+		return
+	}
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		fn(r.ctx)
+		return
+	}
+	r.wg.Add(1)
+	r.running[name]++
+	r.mu.Unlock()
+
+	go func() {
+		defer func() {
+			r.mu.Lock()
+			if r.running[name]--; r.running[name] == 0 {
+				delete(r.running, name)
+			}
+			r.mu.Unlock()
+			r.wg.Done()
+		}()
+		fn(r.ctx)
+	}()
+}
+
+// Every interval마다 fn을 실행하는 작업 등록, 실행 중인 fn은 끝날 때까지 기다리고 다음 실행부터 멈춤
+func (r *Registry) Every(name string, interval time.Duration, fn func(ctx context.Context)) {
+	r.Go(name, func(ctx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				fn(ctx)
+			}
+		}
+	})
+}
+
+// Stop 종료를 시작해서 등록된 작업의 ctx를 취소하고, 기다리지는 않음
+// 종료 신호를 받자마자 호출하면 서버가 요청을 마저 처리하는 동안 준비 상태 확인(/readyz)이 실패함
+func (r *Registry) Stop() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.closed = true
+	r.mu.Unlock()
+	r.cancel()
+}
+
+// Stopping Stop이나 Shutdown으로 종료가 시작됐는지 여부
+func (r *Registry) Stopping() bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.closed
+}
+
+// Shutdown 종료를 시작하고 모든 작업이 끝날 때까지 기다림
+// ctx가 먼저 끝나면 아직 실행 중인 작업 이름과 함께 에러 반환
+func (r *Registry) Shutdown(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+
+	r.Stop()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("끝나지 않은 백그라운드 작업이 있습니다 (%s): %w", strings.Join(r.runningNames(), ", "), ctx.Err())
+	}
+}
+
+// runningNames 실행 중인 작업을 "이름 x개수" 형식으로 정렬해서 반환
+func (r *Registry) runningNames() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, 0, len(r.running))
+	for name, count := range r.running {
+		names = append(names, fmt.Sprintf("%s x%d", name, count))
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+const gormStartKey = "metrics:start"
+
+// GormPlugin 모든 GORM 작업의 쿼리 시간과 실패를 기록하는 플러그인, db.Use로 등록
+type GormPlugin struct{}
+
+func (GormPlugin) Name() string {
+	return "image-hub:metrics"
+}
+
+// Initialize 작업 종류별로 가장 먼저 시작 시각을 남기고, 가장 마지막에 걸린 시간을 기록하는 콜백 등록
+func (GormPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	register := func(operation string, before, after func(name string, fn func(*gorm.DB)) error) error {
+		if err := before("metrics:before_"+operation, startQuery); err != nil {
+			return err
+		}
+		return after("metrics:after_"+operation, observeQuery(operation))
+	}
+	return errors.Join(
+		register("create", callback.Create().Before("*").Register, callback.Create().After("*").Register),
+		register("query", callback.Query().Before("*").Register, callback.Query().After("*").Register),
+		register("update", callback.Update().Before("*").Register, callback.Update().After("*").Register),
+		register("delete", callback.Delete().Before("*").Register, callback.Delete().After("*").Register),
+		register("row", callback.Row().Before("*").Register, callback.Row().After("*").Register),
+		register("raw", callback.Raw().Before("*").Register, callback.Raw().After("*").Register),
+	)
+}
+
+func startQuery(db *gorm.DB) {
+	db.InstanceSet(gormStartKey, time.Now())
+}
+
+func observeQuery(operation string) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(gormStartKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown" // Raw, Exec처럼 모델 없이 실행한 쿼리
+		}
+		dbDuration.WithLabelValues(operation, table).Observe(time.Since(start).Seconds())
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			dbErrors.WithLabelValues(operation, table).Inc()
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// 모든 메트릭은 기본 레지스트리에 등록되고 /metrics로 Go 런타임, 프로세스 메트릭과 함께 내려감
+// 핸들러를 여러 번 만들어도(테스트) 같은 메트릭을 함께 사용
+
+const namespace = "image_hub"
+
+var (
+	// 큰 이미지 업로드와 ZIP 다운로드는 수십 초가 걸리므로 기본 버킷보다 길게 설정
+	httpDurationBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120}
+	// 파일 하나, 쿼리 하나 단위의 짧은 작업, 0.5ms부터 약 8초까지
+	ioDurationBuckets = prometheus.ExponentialBuckets(0.0005, 2, 15)
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "gin 경로별 HTTP 요청 수",
+	}, []string{"method", "route", "status"})
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "gin 경로별 HTTP 요청 처리 시간",
+		Buckets:   httpDurationBuckets,
+	}, []string{"method", "route"})
+
+	uploads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "uploads_total",
+		Help:      "업로드한 이미지 수 (result: success, failure)",
+	}, []string{"result"})
+	uploadBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upload_bytes_total",
+		Help:      "업로드에 성공한 이미지 원본 크기의 합",
+	})
+
+	thumbnailDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "thumbnail_duration_seconds",
+		Help:      "썸네일 생성 시간",
+		Buckets:   prometheus.DefBuckets,
+	})
+	thumbnailFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "thumbnail_failures_total",
+		Help:      "썸네일 생성에 실패한 수",
+	})
+
+	storageDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "storage_operation_duration_seconds",
+		Help:      "저장소 작업 시간 (operation: save, append, open, size, remove)",
+		Buckets:   ioDurationBuckets,
+	}, []string{"operation", "result"})
+
+	dbDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "GORM 작업별 쿼리 시간 (operation: create, query, update, delete, row, raw)",
+		Buckets:   ioDurationBuckets,
+	}, []string{"operation", "table"})
+	dbErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_query_errors_total",
+		Help:      "실패한 GORM 쿼리 수, 레코드를 찾지 못한 경우는 제외",
+	}, []string{"operation", "table"})
+
+	// ActiveImageFileDeletions 응답 후 백그라운드에서 이미지 파일을 삭제하고 있는 고루틴 수
+	ActiveImageFileDeletions = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "image_file_deletions_active",
+		Help:      "백그라운드에서 이미지 파일을 삭제하고 있는 고루틴 수",
+	})
+)
+
+// Handler Prometheus가 수집하는 /metrics 핸들러
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveHTTPRequest route는 경로 변수를 채우지 않은 gin 등록 경로(/api/user/images/:imageID/)
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	httpDuration.WithLabelValues(method, route).Observe(duration.Seconds())
+}
+
+// ObserveUpload 이미지 업로드 결과 기록, 성공한 경우에만 크기를 더함
+func ObserveUpload(size int64, err error) {
+	if err != nil {
+		uploads.WithLabelValues("failure").Inc()
+		return
+	}
+	uploads.WithLabelValues("success").Inc()
+	uploadBytes.Add(float64(size))
+}
+
+// ObserveThumbnail 썸네일 생성 시간과 실패 기록
+func ObserveThumbnail(duration time.Duration, err error) {
+	thumbnailDuration.Observe(duration.Seconds())
+	if err != nil {
+		thumbnailFailures.Inc()
+	}
+}
+
+// ObserveStorage 저장소 작업 시간 기록
+func ObserveStorage(operation string, duration time.Duration, err error) {
+	storageDuration.WithLabelValues(operation, result(err)).Observe(duration.Seconds())
+}
+
+func result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
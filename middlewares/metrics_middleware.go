@@ -0,0 +1,23 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/metrics"
+	"time"
+)
+
+// Metrics - 요청 수와 처리 시간을 gin 등록 경로별로 기록
+// 경로 변수를 채우지 않은 경로(/api/user/images/:imageID/)를 라벨로 써서 이미지 ID마다 라벨이 늘어나지 않도록 함
+func Metrics() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+
+		method, route := ctx.Request.Method, ctx.FullPath()
+		if route == "" {
+			// 등록되지 않은 경로는 임의의 경로와 메서드로 라벨이 늘어나지 않도록 하나로 묶음
+			method, route = "OTHER", "unmatched"
+		}
+		metrics.ObserveHTTPRequest(method, route, ctx.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,22 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+const TusVersion = "1.0.0"
+
+// TusResumable - tus 프로토콜 버전 검증, OPTIONS 요청을 제외하고 Tus-Resumable 헤더가 필요
+func TusResumable() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Header("Tus-Resumable", TusVersion)
+		if ctx.Request.Method != http.MethodOptions && ctx.GetHeader("Tus-Resumable") != TusVersion {
+			ctx.Header("Tus-Version", TusVersion)
+			ctx.JSON(http.StatusPreconditionFailed, gin.H{"error": "지원하지 않는 tus 버전입니다"})
+			ctx.Abort()
+			return
+		}
+		ctx.Next()
+	}
+}
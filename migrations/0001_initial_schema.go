@@ -0,0 +1,243 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+// 0001 처음 스키마
+// 모델이 바뀌어도 이 마이그레이션이 만드는 테이블은 바뀌지 않도록 이 시점의 테이블 구조를 따로 정의한다.
+// scripts/database.sql로 만든 DB는 이미 있는 테이블을 건너뛰고, 0002에서 모델과 다른 부분을 맞춘다.
+// 다만 그 DB의 users.id는 INT라서 BIGINT UNSIGNED인 외래키 컬럼이 가리킬 수 없으므로, 테이블을 만들기 전에 users.id부터 바꾼다.
+
+type user0001 struct {
+	ID        uint   `gorm:"primaryKey;autoIncrement"`
+	Email     string `gorm:"size:255;unique;not null"`
+	Password  string `gorm:"size:255;not null"`
+	Role      string `gorm:"size:10;not null;default:'USER';check:chk_users_role,role IN ('USER', 'ADMIN')"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (user0001) TableName() string { return "users" }
+
+type category0001 struct {
+	ID        uint   `gorm:"primaryKey;autoIncrement"`
+	Name      string `gorm:"size:255;unique;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (category0001) TableName() string { return "categories" }
+
+type categoryAlias0001 struct {
+	Alias      string       `gorm:"primaryKey;size:50"`
+	CategoryID uint         `gorm:"not null"`
+	Category   category0001 `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+func (categoryAlias0001) TableName() string { return "category_aliases" }
+
+type image0001 struct {
+	ID            uint      `gorm:"primaryKey;autoIncrement"`
+	FileName      string    `gorm:"size:255;not null"`
+	FilePath      string    `gorm:"size:255;not null"`
+	ThumbnailPath string    `gorm:"size:255;not null"`
+	ContentHash   string    `gorm:"size:64;index:idx_images_user_id_content_hash,priority:2"`
+	UploadDate    time.Time `gorm:"autoCreateTime"`
+	CapturedAt    *time.Time
+	Description   string `gorm:"type:text"`
+	UserID        uint   `gorm:"not null;index:idx_images_user_id_content_hash,priority:1"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+}
+
+func (image0001) TableName() string { return "images" }
+
+type imageCategory0001 struct {
+	ImageID    uint         `gorm:"primaryKey;autoIncrement:false"`
+	CategoryID uint         `gorm:"primaryKey;autoIncrement:false"`
+	Image      image0001    `gorm:"constraint:OnDelete:CASCADE"`
+	Category   category0001 `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (imageCategory0001) TableName() string { return "image_categories" }
+
+type tag0001 struct {
+	ID        uint   `gorm:"primaryKey;autoIncrement"`
+	Name      string `gorm:"size:50;unique;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (tag0001) TableName() string { return "tags" }
+
+type imageTag0001 struct {
+	ImageID   uint      `gorm:"primaryKey;autoIncrement:false"`
+	TagID     uint      `gorm:"primaryKey;autoIncrement:false;index:idx_image_tags_tag_id"`
+	Image     image0001 `gorm:"constraint:OnDelete:CASCADE"`
+	Tag       tag0001   `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+}
+
+func (imageTag0001) TableName() string { return "image_tags" }
+
+type album0001 struct {
+	ID           uint       `gorm:"primaryKey;autoIncrement"`
+	UserID       uint       `gorm:"not null;index"`
+	Title        string     `gorm:"size:100;not null"`
+	CoverImageID *uint      `gorm:"index"`
+	CoverImage   *image0001 `gorm:"constraint:OnDelete:SET NULL"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (album0001) TableName() string { return "albums" }
+
+type albumImage0001 struct {
+	AlbumID   uint      `gorm:"primaryKey;autoIncrement:false;index:idx_album_images_position,priority:1"`
+	ImageID   uint      `gorm:"primaryKey;autoIncrement:false"`
+	Position  int       `gorm:"not null;index:idx_album_images_position,priority:2"`
+	Album     album0001 `gorm:"constraint:OnDelete:CASCADE"`
+	Image     image0001 `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+}
+
+func (albumImage0001) TableName() string { return "album_images" }
+
+type imageShare0001 struct {
+	ID           uint      `gorm:"primaryKey;autoIncrement"`
+	ImageID      uint      `gorm:"not null;index"`
+	Image        image0001 `gorm:"constraint:OnDelete:CASCADE"`
+	UserID       uint      `gorm:"not null"`
+	TokenHash    string    `gorm:"size:64;unique;not null"`
+	PasswordHash string    `gorm:"size:255;not null;default:''"`
+	ExpiresAt    *time.Time
+	MaxViews     int `gorm:"not null;default:0"`
+	ViewCount    int `gorm:"not null;default:0"`
+	RevokedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+func (imageShare0001) TableName() string { return "image_shares" }
+
+type imagePermission0001 struct {
+	ImageID   uint      `gorm:"primaryKey;autoIncrement:false"`
+	UserID    uint      `gorm:"primaryKey;autoIncrement:false;index"`
+	Image     image0001 `gorm:"constraint:OnDelete:CASCADE"`
+	User      user0001  `gorm:"constraint:OnDelete:CASCADE"`
+	Role      string    `gorm:"size:10;not null"`
+	GrantedBy uint      `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (imagePermission0001) TableName() string { return "image_permissions" }
+
+type uploadSession0001 struct {
+	ID               string    `gorm:"primaryKey;size:32"`
+	UserID           uint      `gorm:"not null;index"`
+	FileName         string    `gorm:"size:255;not null"`
+	Description      string    `gorm:"type:text"`
+	Categories       string    `gorm:"size:255"`
+	StrictCategories bool      `gorm:"not null;default:false"`
+	Length           int64     `gorm:"not null"`
+	Offset           int64     `gorm:"column:upload_offset;not null;default:0"`
+	ExpiresAt        time.Time `gorm:"not null;index"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (uploadSession0001) TableName() string { return "upload_sessions" }
+
+type exportJob0001 struct {
+	ID                uint     `gorm:"primaryKey;autoIncrement"`
+	UserID            uint     `gorm:"not null;index"`
+	User              user0001 `gorm:"constraint:OnDelete:CASCADE"`
+	Status            string   `gorm:"size:10;not null;index"`
+	IncludeRenditions bool     `gorm:"not null;default:false"`
+	FilePath          string   `gorm:"size:255"`
+	Size              int64    `gorm:"not null;default:0"`
+	ImageCount        int      `gorm:"not null;default:0"`
+	Error             string   `gorm:"type:text"`
+	CompletedAt       *time.Time
+	ExpiresAt         *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (exportJob0001) TableName() string { return "export_jobs" }
+
+// tables0001 외래키가 가리키는 테이블이 먼저 오도록 정렬한 테이블, 되돌릴 때는 역순으로 삭제
+var tables0001 = []interface{}{
+	&user0001{},
+	&category0001{},
+	&categoryAlias0001{},
+	&image0001{},
+	&imageCategory0001{},
+	&tag0001{},
+	&imageTag0001{},
+	&album0001{},
+	&albumImage0001{},
+	&imageShare0001{},
+	&imagePermission0001{},
+	&uploadSession0001{},
+	&exportJob0001{},
+}
+
+var initialSchema = Migration{
+	Version: 1,
+	Name:    "initial_schema",
+	Up: func(tx *gorm.DB) error {
+		if err := legacyUserIDColumns(tx); err != nil {
+			return err
+		}
+		for _, table := range tables0001 {
+			if tx.Migrator().HasTable(table) {
+				continue
+			}
+			if err := tx.Migrator().CreateTable(table); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	// scripts/database.sql로 만든 DB에서는 원래 있던 users, images, image_categories를 건너뛰었으므로
+	// 테이블을 모두 삭제하면 마이그레이션 전부터 있던 데이터까지 지우게 됨
+	Down: nil,
+}
+
+// legacyUserIDColumns scripts/database.sql로 만든 MySQL DB의 users.id와 images.user_id를 BIGINT UNSIGNED로 변경
+// 이 DB에는 users.id를 가리키는 다른 컬럼이 없고, 나머지 테이블은 0001이 BIGINT UNSIGNED로 만듦
+// users.id가 INT가 아니거나 users 테이블이 없거나 MySQL이 아니면 아무것도 하지 않음
+func legacyUserIDColumns(tx *gorm.DB) error {
+	if tx.Dialector.Name() != "mysql" || !tx.Migrator().HasTable(&user0001{}) {
+		return nil
+	}
+	columnTypes, err := tx.Migrator().ColumnTypes(&user0001{})
+	if err != nil {
+		return err
+	}
+	for _, columnType := range columnTypes {
+		if columnType.Name() == "id" && !strings.EqualFold(columnType.DatabaseTypeName(), "int") {
+			return nil
+		}
+	}
+
+	statements := []string{
+		`ALTER TABLE users
+    MODIFY id BIGINT UNSIGNED AUTO_INCREMENT,
+    MODIFY email VARCHAR(255) NOT NULL`,
+	}
+	if tx.Migrator().HasTable(&image0001{}) {
+		// 예전 데이터에 NULL이 있을 수 있어 NULL 허용 유지
+		statements = append(statements, `ALTER TABLE images MODIFY user_id BIGINT UNSIGNED NULL`)
+	}
+	return execAll(tx, statements...)
+}
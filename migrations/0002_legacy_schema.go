@@ -0,0 +1,88 @@
+package migrations
+
+import "gorm.io/gorm"
+
+// 0002 scripts/database.sql로 만든 MySQL DB를 0001의 스키마에 맞춤
+// 예전에 손으로 실행하던 scripts/migrations의 0001 ~ 0003을 적용하지 않은 DB는 함께 적용한다.
+// INT였던 users.id와 사용자 ID 컬럼은 외래키를 만들기 전에 바꿔야 하므로 0001에서 바로잡는다.
+// 0001로 새로 만든 DB나 MySQL이 아닌 DB에서는 아무것도 하지 않는다.
+
+var legacySchema = Migration{
+	Version: 2,
+	Name:    "legacy_schema",
+	Up: func(tx *gorm.DB) error {
+		if tx.Dialector.Name() != "mysql" {
+			return nil
+		}
+		steps := []func(tx *gorm.DB) error{
+			legacyImageCategoryKeys,
+			legacyImageColumns,
+		}
+		for _, step := range steps {
+			if err := step(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	// 바로잡은 컬럼 타입과 정리한 중복 데이터는 되돌릴 수 없음
+	Down: nil,
+}
+
+// legacyImageCategoryKeys 예전 scripts/migrations/0001, image_categories의 중복 매핑을 정리하고 복합 기본키와 외래키 추가
+func legacyImageCategoryKeys(tx *gorm.DB) error {
+	if tx.Migrator().HasConstraint(&imageCategory0001{}, "fk_image_categories_image") {
+		return nil
+	}
+	return execAll(tx,
+		`CREATE TABLE image_categories_dedup AS
+SELECT image_categories.image_id,
+       image_categories.category_id,
+       MIN(image_categories.created_at) AS created_at,
+       MAX(image_categories.updated_at) AS updated_at
+FROM image_categories
+         JOIN images ON images.id = image_categories.image_id
+         JOIN categories ON categories.id = image_categories.category_id
+GROUP BY image_categories.image_id, image_categories.category_id`,
+		`DELETE FROM image_categories`,
+		`ALTER TABLE image_categories
+    MODIFY image_id BIGINT UNSIGNED NOT NULL,
+    MODIFY category_id BIGINT UNSIGNED NOT NULL`,
+		`INSERT INTO image_categories (image_id, category_id, created_at, updated_at)
+SELECT image_id, category_id, created_at, updated_at
+FROM image_categories_dedup`,
+		`DROP TABLE image_categories_dedup`,
+		`ALTER TABLE image_categories
+    ADD PRIMARY KEY (image_id, category_id),
+    ADD CONSTRAINT fk_image_categories_image FOREIGN KEY (image_id) REFERENCES images (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_image_categories_category FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE CASCADE`,
+	)
+}
+
+// legacyImageColumns 예전 scripts/migrations/0002, 0003, 원본 해시와 촬영 날짜 컬럼, 사용자별 원본 해시 인덱스 추가
+func legacyImageColumns(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if !migrator.HasColumn(&image0001{}, "ContentHash") {
+		if err := migrator.AddColumn(&image0001{}, "ContentHash"); err != nil {
+			return err
+		}
+	}
+	if !migrator.HasColumn(&image0001{}, "CapturedAt") {
+		if err := migrator.AddColumn(&image0001{}, "CapturedAt"); err != nil {
+			return err
+		}
+	}
+	if !migrator.HasIndex(&image0001{}, "idx_images_user_id_content_hash") {
+		return migrator.CreateIndex(&image0001{}, "idx_images_user_id_content_hash")
+	}
+	return nil
+}
+
+func execAll(tx *gorm.DB, statements ...string) error {
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// 0003 관리자 일괄 가져오기 작업
+// 일괄 가져오기를 요청 안에서 처리하지 않고 내보내기처럼 백그라운드 작업으로 처리하기 위한 테이블
+
+type bulkImportJob0003 struct {
+	ID           uint     `gorm:"primaryKey;autoIncrement"`
+	UserID       uint     `gorm:"not null;index"`
+	User         user0001 `gorm:"constraint:OnDelete:CASCADE"`
+	Status       string   `gorm:"size:10;not null;index"`
+	ArchivePath  string   `gorm:"size:255"`
+	MetadataPath string   `gorm:"size:255"`
+	ReportPath   string   `gorm:"size:255"`
+	ItemCount    int      `gorm:"not null;default:0"`
+	Imported     int      `gorm:"not null;default:0"`
+	Skipped      int      `gorm:"not null;default:0"`
+	Failed       int      `gorm:"not null;default:0"`
+	Error        string   `gorm:"type:text"`
+	CompletedAt  *time.Time
+	ExpiresAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (bulkImportJob0003) TableName() string { return "bulk_import_jobs" }
+
+var bulkImportJobs = Migration{
+	Version: 3,
+	Name:    "bulk_import_jobs",
+	Up: func(tx *gorm.DB) error {
+		return tx.Migrator().CreateTable(&bulkImportJob0003{})
+	},
+	Down: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&bulkImportJob0003{})
+	},
+}
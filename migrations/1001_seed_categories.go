@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// 1001 기본 카테고리와 업로드 시 카테고리명 대신 쓸 수 있는 별칭
+// 이미 있는 카테고리와 별칭은 건너뛰고, 되돌릴 때는 이미지에 붙지 않은 카테고리만 삭제한다.
+
+var seedCategoryNames = []string{"PERSON", "LANDSCAPE", "ANIMAL", "FOOD", "OTHERS"}
+
+// seedCategoryAliases 별칭 -> 카테고리명, 별칭은 대문자로 저장
+var seedCategoryAliases = map[string]string{
+	"PEOPLE":     "PERSON",
+	"PERSONS":    "PERSON",
+	"인물":         "PERSON",
+	"LANDSCAPES": "LANDSCAPE",
+	"SCENERY":    "LANDSCAPE",
+	"NATURE":     "LANDSCAPE",
+	"풍경":         "LANDSCAPE",
+	"ANIMALS":    "ANIMAL",
+	"PET":        "ANIMAL",
+	"PETS":       "ANIMAL",
+	"동물":         "ANIMAL",
+	"FOODS":      "FOOD",
+	"음식":         "FOOD",
+	"OTHER":      "OTHERS",
+	"ETC":        "OTHERS",
+	"기타":         "OTHERS",
+}
+
+var seedCategories = Migration{
+	Version: 1001,
+	Name:    "seed_categories",
+	Up: func(tx *gorm.DB) error {
+		now := time.Now()
+		categoryIDs := make(map[string]uint, len(seedCategoryNames))
+		for _, name := range seedCategoryNames {
+			category := category0001{Name: name, CreatedAt: now, UpdatedAt: now}
+			if err := tx.Unscoped().Where(category0001{Name: name}).FirstOrCreate(&category).Error; err != nil {
+				return err
+			}
+			categoryIDs[name] = category.ID
+		}
+		for alias, name := range seedCategoryAliases {
+			categoryAlias := categoryAlias0001{Alias: alias, CategoryID: categoryIDs[name]}
+			if err := tx.Omit("Category").Where(categoryAlias0001{Alias: alias}).FirstOrCreate(&categoryAlias).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Down: func(tx *gorm.DB) error {
+		aliases := make([]string, 0, len(seedCategoryAliases))
+		for alias := range seedCategoryAliases {
+			aliases = append(aliases, alias)
+		}
+		if err := tx.Where("alias IN ?", aliases).Delete(&categoryAlias0001{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().
+			Where("name IN ? AND id NOT IN (?)", seedCategoryNames, tx.Table("image_categories").Select("category_id")).
+			Delete(&category0001{}).Error
+	},
+}
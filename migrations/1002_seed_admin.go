@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"errors"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 1002 관리자 계정
+// 이미 같은 이메일의 계정이 있으면 건너뛴다.
+// 시드 데이터가 만든 계정인지 원래 있던 계정인지 구분할 수 없으므로 되돌릴 수 없다.
+
+// AdminAccount 시드 데이터로 만들 관리자 계정
+type AdminAccount struct {
+	Email    string
+	Password string
+}
+
+func seedAdmin(admin AdminAccount) Migration {
+	return Migration{
+		Version: 1002,
+		Name:    "seed_admin",
+		Up: func(tx *gorm.DB) error {
+			if admin.Email == "" || len(admin.Password) < 8 {
+				return errors.New("관리자 계정의 이메일과 8자 이상의 비밀번호가 필요합니다")
+			}
+			hashedPassword, err := bcrypt.GenerateFromPassword([]byte(admin.Password), bcrypt.DefaultCost)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			user := user0001{Email: admin.Email, Password: string(hashedPassword), Role: "ADMIN", CreatedAt: now, UpdatedAt: now}
+			return tx.Unscoped().Where(user0001{Email: admin.Email}).FirstOrCreate(&user).Error
+		},
+		Down: nil,
+	}
+}
@@ -0,0 +1,19 @@
+package migrations
+
+// Schema 서버가 동작하는 데 필요한 스키마 마이그레이션
+// 새 마이그레이션은 다음 번호로 파일을 추가하고 여기에 등록
+func Schema() []Migration {
+	return []Migration{
+		initialSchema,
+		legacySchema,
+		bulkImportJobs,
+	}
+}
+
+// Seeds 필요할 때만 적용하는 시드 데이터 마이그레이션, 스키마 마이그레이션과 섞이지 않도록 1000번대 번호 사용
+func Seeds(admin AdminAccount) []Migration {
+	return []Migration{
+		seedCategories,
+		seedAdmin(admin),
+	}
+}
@@ -0,0 +1,161 @@
+package migrations
+
+import (
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"sort"
+	"time"
+)
+
+// 번호를 붙인 스키마 마이그레이션
+// 적용한 마이그레이션은 schema_migrations 테이블에 기록하고, 번호 순서대로 적용하거나 마지막 것부터 되돌린다.
+
+var ErrIrreversible = errors.New("되돌릴 수 없는 마이그레이션입니다")
+
+// Migration Up으로 적용하고 Down으로 되돌리는 스키마 변경 하나
+// Down이 없으면 되돌릴 수 없음
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error
+}
+
+// SchemaMigration 적용한 마이그레이션 기록
+type SchemaMigration struct {
+	Version   int64  `gorm:"primaryKey;autoIncrement:false"`
+	Name      string `gorm:"size:100;not null"`
+	AppliedAt time.Time
+}
+
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Status 마이그레이션별 적용 여부, 적용하지 않았으면 AppliedAt이 nil
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator migrations를 번호 순서대로 적용하고 되돌리는 Migrator 생성
+func NewMigrator(db *gorm.DB, migrations []Migration) *Migrator {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	return &Migrator{db: db, migrations: sorted}
+}
+
+// Up 적용하지 않은 마이그레이션을 번호 순서대로 모두 적용하고, 적용한 마이그레이션 반환
+// 나중에 추가된 낮은 번호의 마이그레이션도 적용하지 않았으면 적용
+func (m *Migrator) Up() ([]Migration, error) {
+	pending, err := m.Pending()
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range pending {
+		// MySQL은 DDL이 트랜잭션으로 묶이지 않으므로 실패하면 DB 상태를 확인한 뒤 다시 실행해야 함
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := migration.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("마이그레이션 %04d_%s를 적용하는데 실패했습니다: %w", migration.Version, migration.Name, err)
+		}
+		applied = append(applied, migration)
+	}
+	return applied, nil
+}
+
+// Down 마지막으로 적용한 마이그레이션 하나를 되돌리고 반환, 되돌릴 마이그레이션이 없으면 nil
+func (m *Migrator) Down() (*Migration, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if migration.Down == nil {
+			return nil, fmt.Errorf("%w: %04d_%s", ErrIrreversible, migration.Version, migration.Name)
+		}
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			if err := migration.Down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaMigration{}, migration.Version).Error
+		})
+		if err != nil {
+			return nil, fmt.Errorf("마이그레이션 %04d_%s를 되돌리는데 실패했습니다: %w", migration.Version, migration.Name, err)
+		}
+		return &migration, nil
+	}
+	return nil, nil
+}
+
+// Status 마이그레이션별 적용 여부를 번호 순서대로 반환
+func (m *Migrator) Status() ([]Status, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if record, ok := applied[migration.Version]; ok {
+			appliedAt := record.AppliedAt
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Pending 아직 적용하지 않은 마이그레이션
+func (m *Migrator) Pending() ([]Migration, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// applied 적용한 마이그레이션 기록, schema_migrations 테이블이 없으면 생성
+func (m *Migrator) applied() (map[int64]SchemaMigration, error) {
+	if !m.db.Migrator().HasTable(&SchemaMigration{}) {
+		if err := m.db.Migrator().CreateTable(&SchemaMigration{}); err != nil {
+			return nil, fmt.Errorf("schema_migrations 테이블을 만드는데 실패했습니다: %v", err)
+		}
+	}
+
+	var records []SchemaMigration
+	if err := m.db.Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("적용한 마이그레이션을 가져오는데 실패했습니다: %v", err)
+	}
+	applied := make(map[int64]SchemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
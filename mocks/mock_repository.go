@@ -5,10 +5,14 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	migrations "github.com/zeze1004/image-hub-platform/migrations"
 	models "github.com/zeze1004/image-hub-platform/models"
+	repositories "github.com/zeze1004/image-hub-platform/repositories"
 )
 
 // MockUserRepository is a mock of UserRepository interface.
@@ -63,6 +67,21 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByEmail(email interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUserRepository)(nil).GetUserByEmail), email)
 }
 
+// GetUserByID mocks base method.
+func (m *MockUserRepository) GetUserByID(userID uint) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", userID)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryMockRecorder) GetUserByID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepository)(nil).GetUserByID), userID)
+}
+
 // MockImageRepository is a mock of ImageRepository interface.
 type MockImageRepository struct {
 	ctrl     *gomock.Controller
@@ -87,17 +106,17 @@ func (m *MockImageRepository) EXPECT() *MockImageRepositoryMockRecorder {
 }
 
 // CreateImage mocks base method.
-func (m *MockImageRepository) CreateImageMetaData(image *models.Image) error {
+func (m *MockImageRepository) CreateImageMetaData(image *models.Image, categoryIDs []uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateImageMetaData", image)
+	ret := m.ctrl.Call(m, "CreateImageMetaData", image, categoryIDs)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateImage indicates an expected call of CreateImage.
-func (mr *MockImageRepositoryMockRecorder) CreateImage(image interface{}) *gomock.Call {
+func (mr *MockImageRepositoryMockRecorder) CreateImage(image, categoryIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImageMetaData", reflect.TypeOf((*MockImageRepository)(nil).CreateImageMetaData), image)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImageMetaData", reflect.TypeOf((*MockImageRepository)(nil).CreateImageMetaData), image, categoryIDs)
 }
 
 // DeleteImage mocks base method.
@@ -128,6 +147,21 @@ func (mr *MockImageRepositoryMockRecorder) DeleteImagesByUserID(userID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImagesByUserID", reflect.TypeOf((*MockImageRepository)(nil).DeleteImagesByUserID), userID)
 }
 
+// FindImageByUserIDAndContentHash mocks base method.
+func (m *MockImageRepository) FindImageByUserIDAndContentHash(userID uint, contentHash string) (*models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindImageByUserIDAndContentHash", userID, contentHash)
+	ret0, _ := ret[0].(*models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImageByUserIDAndContentHash indicates an expected call of FindImageByUserIDAndContentHash.
+func (mr *MockImageRepositoryMockRecorder) FindImageByUserIDAndContentHash(userID, contentHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImageByUserIDAndContentHash", reflect.TypeOf((*MockImageRepository)(nil).FindImageByUserIDAndContentHash), userID, contentHash)
+}
+
 // GetAllImages mocks base method.
 func (m *MockImageRepository) GetAllImages() ([]models.Image, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +177,21 @@ func (mr *MockImageRepositoryMockRecorder) GetAllImages() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllImages", reflect.TypeOf((*MockImageRepository)(nil).GetAllImages))
 }
 
+// GetArchiveImages mocks base method.
+func (m *MockImageRepository) GetArchiveImages(userID, categoryID uint, from, to *time.Time, limit int) ([]models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchiveImages", userID, categoryID, from, to, limit)
+	ret0, _ := ret[0].([]models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchiveImages indicates an expected call of GetArchiveImages.
+func (mr *MockImageRepositoryMockRecorder) GetArchiveImages(userID, categoryID, from, to, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchiveImages", reflect.TypeOf((*MockImageRepository)(nil).GetArchiveImages), userID, categoryID, from, to, limit)
+}
+
 // GetImageByID mocks base method.
 func (m *MockImageRepository) GetImageByID(id uint) (*models.Image, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +207,21 @@ func (mr *MockImageRepositoryMockRecorder) GetImageByID(id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageByID", reflect.TypeOf((*MockImageRepository)(nil).GetImageByID), id)
 }
 
+// GetImagesByIDs mocks base method.
+func (m *MockImageRepository) GetImagesByIDs(ids []uint) ([]models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImagesByIDs", ids)
+	ret0, _ := ret[0].([]models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImagesByIDs indicates an expected call of GetImagesByIDs.
+func (mr *MockImageRepositoryMockRecorder) GetImagesByIDs(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImagesByIDs", reflect.TypeOf((*MockImageRepository)(nil).GetImagesByIDs), ids)
+}
+
 // GetImagesByUserID mocks base method.
 func (m *MockImageRepository) GetImagesByUserID(userID uint) ([]models.Image, error) {
 	m.ctrl.T.Helper()
@@ -173,6 +237,34 @@ func (mr *MockImageRepositoryMockRecorder) GetImagesByUserID(userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImagesByUserID", reflect.TypeOf((*MockImageRepository)(nil).GetImagesByUserID), userID)
 }
 
+// UpdateImageCapturedAt mocks base method.
+func (m *MockImageRepository) UpdateImageCapturedAt(imageID uint, capturedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImageCapturedAt", imageID, capturedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateImageCapturedAt indicates an expected call of UpdateImageCapturedAt.
+func (mr *MockImageRepositoryMockRecorder) UpdateImageCapturedAt(imageID, capturedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageCapturedAt", reflect.TypeOf((*MockImageRepository)(nil).UpdateImageCapturedAt), imageID, capturedAt)
+}
+
+// UpdateImageContentHash mocks base method.
+func (m *MockImageRepository) UpdateImageContentHash(imageID uint, contentHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImageContentHash", imageID, contentHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateImageContentHash indicates an expected call of UpdateImageContentHash.
+func (mr *MockImageRepositoryMockRecorder) UpdateImageContentHash(imageID, contentHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageContentHash", reflect.TypeOf((*MockImageRepository)(nil).UpdateImageContentHash), imageID, contentHash)
+}
+
 // MockCategoryRepository is a mock of CategoryRepository interface.
 type MockCategoryRepository struct {
 	ctrl     *gomock.Controller
@@ -196,6 +288,51 @@ func (m *MockCategoryRepository) EXPECT() *MockCategoryRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountDailyUploadsByCategory mocks base method.
+func (m *MockCategoryRepository) CountDailyUploadsByCategory(from, to time.Time, userID uint) ([]models.DailyCategoryUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDailyUploadsByCategory", from, to, userID)
+	ret0, _ := ret[0].([]models.DailyCategoryUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDailyUploadsByCategory indicates an expected call of CountDailyUploadsByCategory.
+func (mr *MockCategoryRepositoryMockRecorder) CountDailyUploadsByCategory(from, to, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDailyUploadsByCategory", reflect.TypeOf((*MockCategoryRepository)(nil).CountDailyUploadsByCategory), from, to, userID)
+}
+
+// CountImagesByCategory mocks base method.
+func (m *MockCategoryRepository) CountImagesByCategory(userID uint) ([]models.CategoryImageCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountImagesByCategory", userID)
+	ret0, _ := ret[0].([]models.CategoryImageCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountImagesByCategory indicates an expected call of CountImagesByCategory.
+func (mr *MockCategoryRepositoryMockRecorder) CountImagesByCategory(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountImagesByCategory", reflect.TypeOf((*MockCategoryRepository)(nil).CountImagesByCategory), userID)
+}
+
+// CountImagesByUserAndCategory mocks base method.
+func (m *MockCategoryRepository) CountImagesByUserAndCategory(userID uint) ([]models.UserCategoryImageCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountImagesByUserAndCategory", userID)
+	ret0, _ := ret[0].([]models.UserCategoryImageCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountImagesByUserAndCategory indicates an expected call of CountImagesByUserAndCategory.
+func (mr *MockCategoryRepositoryMockRecorder) CountImagesByUserAndCategory(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountImagesByUserAndCategory", reflect.TypeOf((*MockCategoryRepository)(nil).CountImagesByUserAndCategory), userID)
+}
+
 // GetCategoriesByImageID mocks base method.
 func (m *MockCategoryRepository) GetCategoriesByImageID(imageID uint) ([]models.Category, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +363,36 @@ func (mr *MockCategoryRepositoryMockRecorder) GetCategoriesByName(names interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesByName", reflect.TypeOf((*MockCategoryRepository)(nil).GetCategoriesByName), names)
 }
 
+// GetCategoryAliases mocks base method.
+func (m *MockCategoryRepository) GetCategoryAliases(aliases []string) ([]models.CategoryAlias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryAliases", aliases)
+	ret0, _ := ret[0].([]models.CategoryAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryAliases indicates an expected call of GetCategoryAliases.
+func (mr *MockCategoryRepositoryMockRecorder) GetCategoryAliases(aliases interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryAliases", reflect.TypeOf((*MockCategoryRepository)(nil).GetCategoryAliases), aliases)
+}
+
+// GetCategoryNamesByImageIDs mocks base method.
+func (m *MockCategoryRepository) GetCategoryNamesByImageIDs(imageIDs []uint) ([]models.ImageCategoryName, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryNamesByImageIDs", imageIDs)
+	ret0, _ := ret[0].([]models.ImageCategoryName)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryNamesByImageIDs indicates an expected call of GetCategoryNamesByImageIDs.
+func (mr *MockCategoryRepositoryMockRecorder) GetCategoryNamesByImageIDs(imageIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryNamesByImageIDs", reflect.TypeOf((*MockCategoryRepository)(nil).GetCategoryNamesByImageIDs), imageIDs)
+}
+
 // GetImagesByCategoryID mocks base method.
 func (m *MockCategoryRepository) GetImagesByCategoryID(categoryID uint) ([]models.Image, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +489,34 @@ func (mr *MockImageCategoryRepositoryMockRecorder) GetCategoriesByImageID(imageI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesByImageID", reflect.TypeOf((*MockImageCategoryRepository)(nil).GetCategoriesByImageID), imageID)
 }
 
+// LockImage mocks base method.
+func (m *MockImageCategoryRepository) LockImage(imageID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockImage", imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockImage indicates an expected call of LockImage.
+func (mr *MockImageCategoryRepositoryMockRecorder) LockImage(imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockImage", reflect.TypeOf((*MockImageCategoryRepository)(nil).LockImage), imageID)
+}
+
+// RemoveAllCategoriesFromImage mocks base method.
+func (m *MockImageCategoryRepository) RemoveAllCategoriesFromImage(imageID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAllCategoriesFromImage", imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAllCategoriesFromImage indicates an expected call of RemoveAllCategoriesFromImage.
+func (mr *MockImageCategoryRepositoryMockRecorder) RemoveAllCategoriesFromImage(imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAllCategoriesFromImage", reflect.TypeOf((*MockImageCategoryRepository)(nil).RemoveAllCategoriesFromImage), imageID)
+}
+
 // RemoveCategoryFromImage mocks base method.
 func (m *MockImageCategoryRepository) RemoveCategoryFromImage(imageID, categoryID uint) error {
 	m.ctrl.T.Helper()
@@ -335,3 +530,1002 @@ func (mr *MockImageCategoryRepositoryMockRecorder) RemoveCategoryFromImage(image
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCategoryFromImage", reflect.TypeOf((*MockImageCategoryRepository)(nil).RemoveCategoryFromImage), imageID, categoryID)
 }
+
+// Transaction mocks base method.
+func (m *MockImageCategoryRepository) Transaction(fn func(repositories.ImageCategoryRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockImageCategoryRepositoryMockRecorder) Transaction(fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockImageCategoryRepository)(nil).Transaction), fn)
+}
+
+// MockTagRepository is a mock of TagRepository interface.
+type MockTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepositoryMockRecorder
+}
+
+// MockTagRepositoryMockRecorder is the mock recorder for MockTagRepository.
+type MockTagRepositoryMockRecorder struct {
+	mock *MockTagRepository
+}
+
+// NewMockTagRepository creates a new mock instance.
+func NewMockTagRepository(ctrl *gomock.Controller) *MockTagRepository {
+	mock := &MockTagRepository{ctrl: ctrl}
+	mock.recorder = &MockTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepository) EXPECT() *MockTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetImagesByTagName mocks base method.
+func (m *MockTagRepository) GetImagesByTagName(name string) ([]models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImagesByTagName", name)
+	ret0, _ := ret[0].([]models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImagesByTagName indicates an expected call of GetImagesByTagName.
+func (mr *MockTagRepositoryMockRecorder) GetImagesByTagName(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImagesByTagName", reflect.TypeOf((*MockTagRepository)(nil).GetImagesByTagName), name)
+}
+
+// GetImagesByTagNameAndUserID mocks base method.
+func (m *MockTagRepository) GetImagesByTagNameAndUserID(name string, userID uint) ([]models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImagesByTagNameAndUserID", name, userID)
+	ret0, _ := ret[0].([]models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImagesByTagNameAndUserID indicates an expected call of GetImagesByTagNameAndUserID.
+func (mr *MockTagRepositoryMockRecorder) GetImagesByTagNameAndUserID(name, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImagesByTagNameAndUserID", reflect.TypeOf((*MockTagRepository)(nil).GetImagesByTagNameAndUserID), name, userID)
+}
+
+// GetOrCreateTagsByName mocks base method.
+func (m *MockTagRepository) GetOrCreateTagsByName(names []string) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreateTagsByName", names)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrCreateTagsByName indicates an expected call of GetOrCreateTagsByName.
+func (mr *MockTagRepositoryMockRecorder) GetOrCreateTagsByName(names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateTagsByName", reflect.TypeOf((*MockTagRepository)(nil).GetOrCreateTagsByName), names)
+}
+
+// GetTagsByImageID mocks base method.
+func (m *MockTagRepository) GetTagsByImageID(imageID uint) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagsByImageID", imageID)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagsByImageID indicates an expected call of GetTagsByImageID.
+func (mr *MockTagRepositoryMockRecorder) GetTagsByImageID(imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByImageID", reflect.TypeOf((*MockTagRepository)(nil).GetTagsByImageID), imageID)
+}
+
+// GetTagsByName mocks base method.
+func (m *MockTagRepository) GetTagsByName(names []string) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagsByName", names)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagsByName indicates an expected call of GetTagsByName.
+func (mr *MockTagRepositoryMockRecorder) GetTagsByName(names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByName", reflect.TypeOf((*MockTagRepository)(nil).GetTagsByName), names)
+}
+
+// GetTagsByPrefix mocks base method.
+func (m *MockTagRepository) GetTagsByPrefix(prefix string, limit int) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagsByPrefix", prefix, limit)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagsByPrefix indicates an expected call of GetTagsByPrefix.
+func (mr *MockTagRepositoryMockRecorder) GetTagsByPrefix(prefix, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByPrefix", reflect.TypeOf((*MockTagRepository)(nil).GetTagsByPrefix), prefix, limit)
+}
+
+// GetTagsByPrefixAndUserID mocks base method.
+func (m *MockTagRepository) GetTagsByPrefixAndUserID(prefix string, userID uint, limit int) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagsByPrefixAndUserID", prefix, userID, limit)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagsByPrefixAndUserID indicates an expected call of GetTagsByPrefixAndUserID.
+func (mr *MockTagRepositoryMockRecorder) GetTagsByPrefixAndUserID(prefix, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByPrefixAndUserID", reflect.TypeOf((*MockTagRepository)(nil).GetTagsByPrefixAndUserID), prefix, userID, limit)
+}
+
+// MockImageTagRepository is a mock of ImageTagRepository interface.
+type MockImageTagRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageTagRepositoryMockRecorder
+}
+
+// MockImageTagRepositoryMockRecorder is the mock recorder for MockImageTagRepository.
+type MockImageTagRepositoryMockRecorder struct {
+	mock *MockImageTagRepository
+}
+
+// NewMockImageTagRepository creates a new mock instance.
+func NewMockImageTagRepository(ctrl *gomock.Controller) *MockImageTagRepository {
+	mock := &MockImageTagRepository{ctrl: ctrl}
+	mock.recorder = &MockImageTagRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageTagRepository) EXPECT() *MockImageTagRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddTagsToImage mocks base method.
+func (m *MockImageTagRepository) AddTagsToImage(imageID uint, tagIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagsToImage", imageID, tagIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTagsToImage indicates an expected call of AddTagsToImage.
+func (mr *MockImageTagRepositoryMockRecorder) AddTagsToImage(imageID, tagIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsToImage", reflect.TypeOf((*MockImageTagRepository)(nil).AddTagsToImage), imageID, tagIDs)
+}
+
+// GetTagIDsByImageID mocks base method.
+func (m *MockImageTagRepository) GetTagIDsByImageID(imageID uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagIDsByImageID", imageID)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagIDsByImageID indicates an expected call of GetTagIDsByImageID.
+func (mr *MockImageTagRepositoryMockRecorder) GetTagIDsByImageID(imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagIDsByImageID", reflect.TypeOf((*MockImageTagRepository)(nil).GetTagIDsByImageID), imageID)
+}
+
+// LockImage mocks base method.
+func (m *MockImageTagRepository) LockImage(imageID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockImage", imageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockImage indicates an expected call of LockImage.
+func (mr *MockImageTagRepositoryMockRecorder) LockImage(imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockImage", reflect.TypeOf((*MockImageTagRepository)(nil).LockImage), imageID)
+}
+
+// RemoveTagFromImage mocks base method.
+func (m *MockImageTagRepository) RemoveTagFromImage(imageID, tagID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTagFromImage", imageID, tagID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTagFromImage indicates an expected call of RemoveTagFromImage.
+func (mr *MockImageTagRepositoryMockRecorder) RemoveTagFromImage(imageID, tagID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagFromImage", reflect.TypeOf((*MockImageTagRepository)(nil).RemoveTagFromImage), imageID, tagID)
+}
+
+// Transaction mocks base method.
+func (m *MockImageTagRepository) Transaction(fn func(repositories.ImageTagRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockImageTagRepositoryMockRecorder) Transaction(fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockImageTagRepository)(nil).Transaction), fn)
+}
+
+// MockAlbumRepository is a mock of AlbumRepository interface.
+type MockAlbumRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlbumRepositoryMockRecorder
+}
+
+// MockAlbumRepositoryMockRecorder is the mock recorder for MockAlbumRepository.
+type MockAlbumRepositoryMockRecorder struct {
+	mock *MockAlbumRepository
+}
+
+// NewMockAlbumRepository creates a new mock instance.
+func NewMockAlbumRepository(ctrl *gomock.Controller) *MockAlbumRepository {
+	mock := &MockAlbumRepository{ctrl: ctrl}
+	mock.recorder = &MockAlbumRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlbumRepository) EXPECT() *MockAlbumRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddImagesToAlbum mocks base method.
+func (m *MockAlbumRepository) AddImagesToAlbum(albumID uint, imageIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImagesToAlbum", albumID, imageIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddImagesToAlbum indicates an expected call of AddImagesToAlbum.
+func (mr *MockAlbumRepositoryMockRecorder) AddImagesToAlbum(albumID, imageIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImagesToAlbum", reflect.TypeOf((*MockAlbumRepository)(nil).AddImagesToAlbum), albumID, imageIDs)
+}
+
+// CountImagesByAlbumIDs mocks base method.
+func (m *MockAlbumRepository) CountImagesByAlbumIDs(albumIDs []uint) ([]models.AlbumImageCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountImagesByAlbumIDs", albumIDs)
+	ret0, _ := ret[0].([]models.AlbumImageCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountImagesByAlbumIDs indicates an expected call of CountImagesByAlbumIDs.
+func (mr *MockAlbumRepositoryMockRecorder) CountImagesByAlbumIDs(albumIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountImagesByAlbumIDs", reflect.TypeOf((*MockAlbumRepository)(nil).CountImagesByAlbumIDs), albumIDs)
+}
+
+// CreateAlbum mocks base method.
+func (m *MockAlbumRepository) CreateAlbum(album *models.Album) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlbum", album)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAlbum indicates an expected call of CreateAlbum.
+func (mr *MockAlbumRepositoryMockRecorder) CreateAlbum(album interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlbum", reflect.TypeOf((*MockAlbumRepository)(nil).CreateAlbum), album)
+}
+
+// DeleteAlbum mocks base method.
+func (m *MockAlbumRepository) DeleteAlbum(albumID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlbum", albumID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlbum indicates an expected call of DeleteAlbum.
+func (mr *MockAlbumRepositoryMockRecorder) DeleteAlbum(albumID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlbum", reflect.TypeOf((*MockAlbumRepository)(nil).DeleteAlbum), albumID)
+}
+
+// GetAlbumByID mocks base method.
+func (m *MockAlbumRepository) GetAlbumByID(albumID uint) (*models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumByID", albumID)
+	ret0, _ := ret[0].(*models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumByID indicates an expected call of GetAlbumByID.
+func (mr *MockAlbumRepositoryMockRecorder) GetAlbumByID(albumID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumByID", reflect.TypeOf((*MockAlbumRepository)(nil).GetAlbumByID), albumID)
+}
+
+// GetAlbumImageIDs mocks base method.
+func (m *MockAlbumRepository) GetAlbumImageIDs(albumID uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumImageIDs", albumID)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumImageIDs indicates an expected call of GetAlbumImageIDs.
+func (mr *MockAlbumRepositoryMockRecorder) GetAlbumImageIDs(albumID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumImageIDs", reflect.TypeOf((*MockAlbumRepository)(nil).GetAlbumImageIDs), albumID)
+}
+
+// GetAlbumImages mocks base method.
+func (m *MockAlbumRepository) GetAlbumImages(albumID uint, limit int) ([]models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumImages", albumID, limit)
+	ret0, _ := ret[0].([]models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumImages indicates an expected call of GetAlbumImages.
+func (mr *MockAlbumRepositoryMockRecorder) GetAlbumImages(albumID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumImages", reflect.TypeOf((*MockAlbumRepository)(nil).GetAlbumImages), albumID, limit)
+}
+
+// GetAlbumPreviewImageIDs mocks base method.
+func (m *MockAlbumRepository) GetAlbumPreviewImageIDs(albumIDs []uint, limit int) ([]models.AlbumImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumPreviewImageIDs", albumIDs, limit)
+	ret0, _ := ret[0].([]models.AlbumImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumPreviewImageIDs indicates an expected call of GetAlbumPreviewImageIDs.
+func (mr *MockAlbumRepositoryMockRecorder) GetAlbumPreviewImageIDs(albumIDs, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumPreviewImageIDs", reflect.TypeOf((*MockAlbumRepository)(nil).GetAlbumPreviewImageIDs), albumIDs, limit)
+}
+
+// GetAlbumsByUserID mocks base method.
+func (m *MockAlbumRepository) GetAlbumsByUserID(userID uint) ([]models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumsByUserID", userID)
+	ret0, _ := ret[0].([]models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumsByUserID indicates an expected call of GetAlbumsByUserID.
+func (mr *MockAlbumRepositoryMockRecorder) GetAlbumsByUserID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsByUserID", reflect.TypeOf((*MockAlbumRepository)(nil).GetAlbumsByUserID), userID)
+}
+
+// RemoveImageFromAlbum mocks base method.
+func (m *MockAlbumRepository) RemoveImageFromAlbum(albumID, imageID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveImageFromAlbum", albumID, imageID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveImageFromAlbum indicates an expected call of RemoveImageFromAlbum.
+func (mr *MockAlbumRepositoryMockRecorder) RemoveImageFromAlbum(albumID, imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveImageFromAlbum", reflect.TypeOf((*MockAlbumRepository)(nil).RemoveImageFromAlbum), albumID, imageID)
+}
+
+// ReorderAlbumImages mocks base method.
+func (m *MockAlbumRepository) ReorderAlbumImages(albumID uint, imageIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderAlbumImages", albumID, imageIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderAlbumImages indicates an expected call of ReorderAlbumImages.
+func (mr *MockAlbumRepositoryMockRecorder) ReorderAlbumImages(albumID, imageIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderAlbumImages", reflect.TypeOf((*MockAlbumRepository)(nil).ReorderAlbumImages), albumID, imageIDs)
+}
+
+// UpdateAlbum mocks base method.
+func (m *MockAlbumRepository) UpdateAlbum(album *models.Album) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlbum", album)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlbum indicates an expected call of UpdateAlbum.
+func (mr *MockAlbumRepositoryMockRecorder) UpdateAlbum(album interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbum", reflect.TypeOf((*MockAlbumRepository)(nil).UpdateAlbum), album)
+}
+
+// MockShareRepository is a mock of ShareRepository interface.
+type MockShareRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareRepositoryMockRecorder
+}
+
+// MockShareRepositoryMockRecorder is the mock recorder for MockShareRepository.
+type MockShareRepositoryMockRecorder struct {
+	mock *MockShareRepository
+}
+
+// NewMockShareRepository creates a new mock instance.
+func NewMockShareRepository(ctrl *gomock.Controller) *MockShareRepository {
+	mock := &MockShareRepository{ctrl: ctrl}
+	mock.recorder = &MockShareRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShareRepository) EXPECT() *MockShareRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateShare mocks base method.
+func (m *MockShareRepository) CreateShare(share *models.ImageShare) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShare", share)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateShare indicates an expected call of CreateShare.
+func (mr *MockShareRepositoryMockRecorder) CreateShare(share interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShare", reflect.TypeOf((*MockShareRepository)(nil).CreateShare), share)
+}
+
+// GetShareByTokenHash mocks base method.
+func (m *MockShareRepository) GetShareByTokenHash(tokenHash string) (*models.ImageShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShareByTokenHash", tokenHash)
+	ret0, _ := ret[0].(*models.ImageShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShareByTokenHash indicates an expected call of GetShareByTokenHash.
+func (mr *MockShareRepositoryMockRecorder) GetShareByTokenHash(tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareByTokenHash", reflect.TypeOf((*MockShareRepository)(nil).GetShareByTokenHash), tokenHash)
+}
+
+// GetSharesByImageID mocks base method.
+func (m *MockShareRepository) GetSharesByImageID(imageID uint) ([]models.ImageShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharesByImageID", imageID)
+	ret0, _ := ret[0].([]models.ImageShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharesByImageID indicates an expected call of GetSharesByImageID.
+func (mr *MockShareRepositoryMockRecorder) GetSharesByImageID(imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharesByImageID", reflect.TypeOf((*MockShareRepository)(nil).GetSharesByImageID), imageID)
+}
+
+// IncrementViewCount mocks base method.
+func (m *MockShareRepository) IncrementViewCount(shareID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementViewCount", shareID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementViewCount indicates an expected call of IncrementViewCount.
+func (mr *MockShareRepositoryMockRecorder) IncrementViewCount(shareID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementViewCount", reflect.TypeOf((*MockShareRepository)(nil).IncrementViewCount), shareID)
+}
+
+// RevokeShare mocks base method.
+func (m *MockShareRepository) RevokeShare(imageID, shareID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShare", imageID, shareID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeShare indicates an expected call of RevokeShare.
+func (mr *MockShareRepositoryMockRecorder) RevokeShare(imageID, shareID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShare", reflect.TypeOf((*MockShareRepository)(nil).RevokeShare), imageID, shareID)
+}
+
+// MockPermissionRepository is a mock of PermissionRepository interface.
+type MockPermissionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPermissionRepositoryMockRecorder
+}
+
+// MockPermissionRepositoryMockRecorder is the mock recorder for MockPermissionRepository.
+type MockPermissionRepositoryMockRecorder struct {
+	mock *MockPermissionRepository
+}
+
+// NewMockPermissionRepository creates a new mock instance.
+func NewMockPermissionRepository(ctrl *gomock.Controller) *MockPermissionRepository {
+	mock := &MockPermissionRepository{ctrl: ctrl}
+	mock.recorder = &MockPermissionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPermissionRepository) EXPECT() *MockPermissionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetImagesSharedWithUser mocks base method.
+func (m *MockPermissionRepository) GetImagesSharedWithUser(userID uint) ([]models.SharedImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImagesSharedWithUser", userID)
+	ret0, _ := ret[0].([]models.SharedImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImagesSharedWithUser indicates an expected call of GetImagesSharedWithUser.
+func (mr *MockPermissionRepositoryMockRecorder) GetImagesSharedWithUser(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImagesSharedWithUser", reflect.TypeOf((*MockPermissionRepository)(nil).GetImagesSharedWithUser), userID)
+}
+
+// GetPermissionRole mocks base method.
+func (m *MockPermissionRepository) GetPermissionRole(imageID, userID uint) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPermissionRole", imageID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermissionRole indicates an expected call of GetPermissionRole.
+func (mr *MockPermissionRepositoryMockRecorder) GetPermissionRole(imageID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissionRole", reflect.TypeOf((*MockPermissionRepository)(nil).GetPermissionRole), imageID, userID)
+}
+
+// GetPermissionsByImageID mocks base method.
+func (m *MockPermissionRepository) GetPermissionsByImageID(imageID uint) ([]models.ImagePermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPermissionsByImageID", imageID)
+	ret0, _ := ret[0].([]models.ImagePermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermissionsByImageID indicates an expected call of GetPermissionsByImageID.
+func (mr *MockPermissionRepositoryMockRecorder) GetPermissionsByImageID(imageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissionsByImageID", reflect.TypeOf((*MockPermissionRepository)(nil).GetPermissionsByImageID), imageID)
+}
+
+// GetPermissionsByImageIDsAndUserID mocks base method.
+func (m *MockPermissionRepository) GetPermissionsByImageIDsAndUserID(imageIDs []uint, userID uint) ([]models.ImagePermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPermissionsByImageIDsAndUserID", imageIDs, userID)
+	ret0, _ := ret[0].([]models.ImagePermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermissionsByImageIDsAndUserID indicates an expected call of GetPermissionsByImageIDsAndUserID.
+func (mr *MockPermissionRepositoryMockRecorder) GetPermissionsByImageIDsAndUserID(imageIDs, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissionsByImageIDsAndUserID", reflect.TypeOf((*MockPermissionRepository)(nil).GetPermissionsByImageIDsAndUserID), imageIDs, userID)
+}
+
+// GrantPermission mocks base method.
+func (m *MockPermissionRepository) GrantPermission(permission *models.ImagePermission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantPermission", permission)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantPermission indicates an expected call of GrantPermission.
+func (mr *MockPermissionRepositoryMockRecorder) GrantPermission(permission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantPermission", reflect.TypeOf((*MockPermissionRepository)(nil).GrantPermission), permission)
+}
+
+// RevokePermission mocks base method.
+func (m *MockPermissionRepository) RevokePermission(imageID, userID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokePermission", imageID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokePermission indicates an expected call of RevokePermission.
+func (mr *MockPermissionRepositoryMockRecorder) RevokePermission(imageID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokePermission", reflect.TypeOf((*MockPermissionRepository)(nil).RevokePermission), imageID, userID)
+}
+
+// MockUploadRepository is a mock of UploadRepository interface.
+type MockUploadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadRepositoryMockRecorder
+}
+
+// MockUploadRepositoryMockRecorder is the mock recorder for MockUploadRepository.
+type MockUploadRepositoryMockRecorder struct {
+	mock *MockUploadRepository
+}
+
+// NewMockUploadRepository creates a new mock instance.
+func NewMockUploadRepository(ctrl *gomock.Controller) *MockUploadRepository {
+	mock := &MockUploadRepository{ctrl: ctrl}
+	mock.recorder = &MockUploadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadRepository) EXPECT() *MockUploadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateUpload mocks base method.
+func (m *MockUploadRepository) CreateUpload(upload *models.UploadSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUpload", upload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUpload indicates an expected call of CreateUpload.
+func (mr *MockUploadRepositoryMockRecorder) CreateUpload(upload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpload", reflect.TypeOf((*MockUploadRepository)(nil).CreateUpload), upload)
+}
+
+// DeleteUpload mocks base method.
+func (m *MockUploadRepository) DeleteUpload(uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUpload", uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUpload indicates an expected call of DeleteUpload.
+func (mr *MockUploadRepositoryMockRecorder) DeleteUpload(uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*MockUploadRepository)(nil).DeleteUpload), uploadID)
+}
+
+// GetExpiredUploads mocks base method.
+func (m *MockUploadRepository) GetExpiredUploads(before time.Time) ([]models.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredUploads", before)
+	ret0, _ := ret[0].([]models.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredUploads indicates an expected call of GetExpiredUploads.
+func (mr *MockUploadRepositoryMockRecorder) GetExpiredUploads(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredUploads", reflect.TypeOf((*MockUploadRepository)(nil).GetExpiredUploads), before)
+}
+
+// GetUploadByID mocks base method.
+func (m *MockUploadRepository) GetUploadByID(uploadID string) (*models.UploadSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadByID", uploadID)
+	ret0, _ := ret[0].(*models.UploadSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadByID indicates an expected call of GetUploadByID.
+func (mr *MockUploadRepositoryMockRecorder) GetUploadByID(uploadID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadByID", reflect.TypeOf((*MockUploadRepository)(nil).GetUploadByID), uploadID)
+}
+
+// UpdateUploadOffset mocks base method.
+func (m *MockUploadRepository) UpdateUploadOffset(uploadID string, offset int64, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUploadOffset", uploadID, offset, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUploadOffset indicates an expected call of UpdateUploadOffset.
+func (mr *MockUploadRepositoryMockRecorder) UpdateUploadOffset(uploadID, offset, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUploadOffset", reflect.TypeOf((*MockUploadRepository)(nil).UpdateUploadOffset), uploadID, offset, expiresAt)
+}
+
+// MockExportRepository is a mock of ExportRepository interface.
+type MockExportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportRepositoryMockRecorder
+}
+
+// MockExportRepositoryMockRecorder is the mock recorder for MockExportRepository.
+type MockExportRepositoryMockRecorder struct {
+	mock *MockExportRepository
+}
+
+// NewMockExportRepository creates a new mock instance.
+func NewMockExportRepository(ctrl *gomock.Controller) *MockExportRepository {
+	mock := &MockExportRepository{ctrl: ctrl}
+	mock.recorder = &MockExportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportRepository) EXPECT() *MockExportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateExportJobIfIdle mocks base method.
+func (m *MockExportRepository) CreateExportJobIfIdle(job *models.ExportJob) (*models.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExportJobIfIdle", job)
+	ret0, _ := ret[0].(*models.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExportJobIfIdle indicates an expected call of CreateExportJobIfIdle.
+func (mr *MockExportRepositoryMockRecorder) CreateExportJobIfIdle(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExportJobIfIdle", reflect.TypeOf((*MockExportRepository)(nil).CreateExportJobIfIdle), job)
+}
+
+// GetExpiredExportJobs mocks base method.
+func (m *MockExportRepository) GetExpiredExportJobs(before time.Time) ([]models.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredExportJobs", before)
+	ret0, _ := ret[0].([]models.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredExportJobs indicates an expected call of GetExpiredExportJobs.
+func (mr *MockExportRepositoryMockRecorder) GetExpiredExportJobs(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredExportJobs", reflect.TypeOf((*MockExportRepository)(nil).GetExpiredExportJobs), before)
+}
+
+// GetExportJobByID mocks base method.
+func (m *MockExportRepository) GetExportJobByID(jobID uint) (*models.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExportJobByID", jobID)
+	ret0, _ := ret[0].(*models.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExportJobByID indicates an expected call of GetExportJobByID.
+func (mr *MockExportRepositoryMockRecorder) GetExportJobByID(jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExportJobByID", reflect.TypeOf((*MockExportRepository)(nil).GetExportJobByID), jobID)
+}
+
+// GetExportJobsByStatus mocks base method.
+func (m *MockExportRepository) GetExportJobsByStatus(status string) ([]models.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExportJobsByStatus", status)
+	ret0, _ := ret[0].([]models.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExportJobsByStatus indicates an expected call of GetExportJobsByStatus.
+func (mr *MockExportRepositoryMockRecorder) GetExportJobsByStatus(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExportJobsByStatus", reflect.TypeOf((*MockExportRepository)(nil).GetExportJobsByStatus), status)
+}
+
+// GetExportJobsByUserID mocks base method.
+func (m *MockExportRepository) GetExportJobsByUserID(userID uint) ([]models.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExportJobsByUserID", userID)
+	ret0, _ := ret[0].([]models.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExportJobsByUserID indicates an expected call of GetExportJobsByUserID.
+func (mr *MockExportRepositoryMockRecorder) GetExportJobsByUserID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExportJobsByUserID", reflect.TypeOf((*MockExportRepository)(nil).GetExportJobsByUserID), userID)
+}
+
+// UpdateExportJob mocks base method.
+func (m *MockExportRepository) UpdateExportJob(job *models.ExportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExportJob", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateExportJob indicates an expected call of UpdateExportJob.
+func (mr *MockExportRepositoryMockRecorder) UpdateExportJob(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExportJob", reflect.TypeOf((*MockExportRepository)(nil).UpdateExportJob), job)
+}
+
+// MockBulkImportRepository is a mock of BulkImportRepository interface.
+type MockBulkImportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkImportRepositoryMockRecorder
+}
+
+// MockBulkImportRepositoryMockRecorder is the mock recorder for MockBulkImportRepository.
+type MockBulkImportRepositoryMockRecorder struct {
+	mock *MockBulkImportRepository
+}
+
+// NewMockBulkImportRepository creates a new mock instance.
+func NewMockBulkImportRepository(ctrl *gomock.Controller) *MockBulkImportRepository {
+	mock := &MockBulkImportRepository{ctrl: ctrl}
+	mock.recorder = &MockBulkImportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkImportRepository) EXPECT() *MockBulkImportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBulkImportJob mocks base method.
+func (m *MockBulkImportRepository) CreateBulkImportJob(job *models.BulkImportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBulkImportJob", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBulkImportJob indicates an expected call of CreateBulkImportJob.
+func (mr *MockBulkImportRepositoryMockRecorder) CreateBulkImportJob(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBulkImportJob", reflect.TypeOf((*MockBulkImportRepository)(nil).CreateBulkImportJob), job)
+}
+
+// GetBulkImportJobByID mocks base method.
+func (m *MockBulkImportRepository) GetBulkImportJobByID(jobID uint) (*models.BulkImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBulkImportJobByID", jobID)
+	ret0, _ := ret[0].(*models.BulkImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBulkImportJobByID indicates an expected call of GetBulkImportJobByID.
+func (mr *MockBulkImportRepositoryMockRecorder) GetBulkImportJobByID(jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkImportJobByID", reflect.TypeOf((*MockBulkImportRepository)(nil).GetBulkImportJobByID), jobID)
+}
+
+// GetBulkImportJobsByStatus mocks base method.
+func (m *MockBulkImportRepository) GetBulkImportJobsByStatus(status string) ([]models.BulkImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBulkImportJobsByStatus", status)
+	ret0, _ := ret[0].([]models.BulkImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBulkImportJobsByStatus indicates an expected call of GetBulkImportJobsByStatus.
+func (mr *MockBulkImportRepositoryMockRecorder) GetBulkImportJobsByStatus(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkImportJobsByStatus", reflect.TypeOf((*MockBulkImportRepository)(nil).GetBulkImportJobsByStatus), status)
+}
+
+// GetExpiredBulkImportJobs mocks base method.
+func (m *MockBulkImportRepository) GetExpiredBulkImportJobs(before time.Time) ([]models.BulkImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredBulkImportJobs", before)
+	ret0, _ := ret[0].([]models.BulkImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredBulkImportJobs indicates an expected call of GetExpiredBulkImportJobs.
+func (mr *MockBulkImportRepositoryMockRecorder) GetExpiredBulkImportJobs(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredBulkImportJobs", reflect.TypeOf((*MockBulkImportRepository)(nil).GetExpiredBulkImportJobs), before)
+}
+
+// UpdateBulkImportJob mocks base method.
+func (m *MockBulkImportRepository) UpdateBulkImportJob(job *models.BulkImportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBulkImportJob", job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBulkImportJob indicates an expected call of UpdateBulkImportJob.
+func (mr *MockBulkImportRepositoryMockRecorder) UpdateBulkImportJob(job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBulkImportJob", reflect.TypeOf((*MockBulkImportRepository)(nil).UpdateBulkImportJob), job)
+}
+
+// MockHealthRepository is a mock of HealthRepository interface.
+type MockHealthRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthRepositoryMockRecorder
+}
+
+// MockHealthRepositoryMockRecorder is the mock recorder for MockHealthRepository.
+type MockHealthRepositoryMockRecorder struct {
+	mock *MockHealthRepository
+}
+
+// NewMockHealthRepository creates a new mock instance.
+func NewMockHealthRepository(ctrl *gomock.Controller) *MockHealthRepository {
+	mock := &MockHealthRepository{ctrl: ctrl}
+	mock.recorder = &MockHealthRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealthRepository) EXPECT() *MockHealthRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetPendingMigrations mocks base method.
+func (m *MockHealthRepository) GetPendingMigrations(ctx context.Context) ([]migrations.Migration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingMigrations", ctx)
+	ret0, _ := ret[0].([]migrations.Migration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingMigrations indicates an expected call of GetPendingMigrations.
+func (mr *MockHealthRepositoryMockRecorder) GetPendingMigrations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingMigrations", reflect.TypeOf((*MockHealthRepository)(nil).GetPendingMigrations), ctx)
+}
+
+// Ping mocks base method.
+func (m *MockHealthRepository) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockHealthRepositoryMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockHealthRepository)(nil).Ping), ctx)
+}
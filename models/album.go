@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// Album 사용자가 이미지를 원하는 순서로 묶는 앨범
+type Album struct {
+	ID           uint   `gorm:"primaryKey;autoIncrement"`
+	UserID       uint   `gorm:"not null;index"` // 앨범 소유자 ID
+	Title        string `gorm:"size:100;not null"`
+	CoverImageID *uint  // 대표 이미지 ID, 없으면 첫 번째 이미지를 대표 이미지로 사용
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// AlbumImage 앨범-이미지 매핑, Position 오름차순으로 앨범 안의 순서를 나타냄
+type AlbumImage struct {
+	AlbumID   uint `gorm:"column:album_id;primaryKey;autoIncrement:false"`
+	ImageID   uint `gorm:"column:image_id;primaryKey;autoIncrement:false"`
+	Position  int  `gorm:"not null"`
+	CreatedAt time.Time
+}
+
+func (AlbumImage) TableName() string {
+	return "album_images"
+}
+
+// AlbumSummary 앨범 목록 조회용 요약 정보
+type AlbumSummary struct {
+	Album
+	ImageCount int64
+	Thumbnails []Image // 대표 이미지를 포함한 미리보기 이미지
+}
+
+// AlbumImageCount 앨범별 이미지 수, 여러 앨범의 이미지 수를 한 번에 조회할 때 사용
+type AlbumImageCount struct {
+	AlbumID    uint
+	ImageCount int64
+}
+
+// AlbumDetail 앨범과 순서대로 정렬된 이미지
+type AlbumDetail struct {
+	Album
+	Images []Image
+}
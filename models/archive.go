@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// ArchiveManifest 이미지 ZIP 압축 파일에 함께 담는 manifest.json
+type ArchiveManifest struct {
+	CreatedAt  time.Time              `json:"created_at"`
+	ImageCount int                    `json:"image_count"`
+	Images     []ArchiveManifestImage `json:"images"`
+}
+
+// ArchiveManifestImage manifest.json의 이미지별 메타데이터
+type ArchiveManifestImage struct {
+	ID            uint      `json:"id"`
+	FileName      string    `json:"file_name"`
+	Path          string    `json:"path,omitempty"`           // 압축 파일 안의 경로
+	ThumbnailPath string    `json:"thumbnail_path,omitempty"` // 썸네일을 포함한 경우 압축 파일 안의 썸네일 경로
+	Description   string    `json:"description"`
+	UserID        uint      `json:"user_id"`
+	UploadDate    time.Time `json:"upload_date"`
+	Categories    []string  `json:"categories"`
+	ContentHash   string    `json:"content_hash,omitempty"`
+	Error         string    `json:"error,omitempty"` // 원본 파일을 읽지 못해 압축 파일에 포함하지 못한 경우
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+const (
+	BulkImportJobStatusPending   = "PENDING"   // 대기 중
+	BulkImportJobStatusRunning   = "RUNNING"   // 가져오는 중
+	BulkImportJobStatusCompleted = "COMPLETED" // 완료, 보관 기간 동안 결과 파일 다운로드 가능
+	BulkImportJobStatusFailed    = "FAILED"    // ZIP이나 메타데이터 파일을 읽지 못해서 가져오지 못함
+	BulkImportJobStatusExpired   = "EXPIRED"   // 보관 기간이 지나 결과 파일 삭제됨
+)
+
+// BulkImportJob 관리자가 올린 ZIP의 이미지를 메타데이터 파일에 따라 각 소유자에게 가져오는 작업
+// 항목별 결과는 CSV 결과 파일로 저장하고, 작업에는 개수만 기록
+type BulkImportJob struct {
+	ID           uint   `gorm:"primaryKey;autoIncrement"`
+	UserID       uint   `gorm:"not null;index"` // 요청한 관리자 ID
+	Status       string `gorm:"size:10;not null;index"`
+	ArchivePath  string `json:"-"` // 저장소에 보관한 ZIP, 작업이 끝나면 삭제
+	MetadataPath string `json:"-"` // 따로 받은 메타데이터 파일, 없으면 ZIP 최상위의 metadata.csv, metadata.json 사용
+	ReportPath   string `json:"-"`
+	ItemCount    int    `gorm:"not null;default:0"` // 메타데이터 파일의 항목 수
+	Imported     int    `gorm:"not null;default:0"`
+	Skipped      int    `gorm:"not null;default:0"`
+	Failed       int    `gorm:"not null;default:0"`
+	Error        string // 작업 실패 사유
+	ReportURL    string `gorm:"-"` // 완료된 작업의 서명된 결과 파일 URL
+	CompletedAt  *time.Time
+	ExpiresAt    *time.Time // 이 시각이 지나면 결과 파일 삭제
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
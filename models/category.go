@@ -1,9 +1,14 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"gorm.io/gorm"
+	"time"
+)
 
 type Category struct {
-	gorm.Model
-	ID   uint   `gorm:"primaryKey;autoIncrement"`
-	Name string `gorm:"unique;not null"`
+	ID        uint   `gorm:"primaryKey;autoIncrement"`
+	Name      string `gorm:"size:255;unique;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
@@ -0,0 +1,8 @@
+package models
+
+// CategoryAlias 카테고리의 별칭(예: ANIMALS -> ANIMAL), 업로드 시 카테고리명 매칭에 사용
+type CategoryAlias struct {
+	Alias      string   `gorm:"primaryKey;size:50"` // 대문자로 정규화된 별칭
+	CategoryID uint     `gorm:"not null"`
+	Category   Category `gorm:"foreignKey:CategoryID"`
+}
@@ -0,0 +1,31 @@
+package models
+
+// CategoryImageCount 카테고리별 이미지 수
+type CategoryImageCount struct {
+	CategoryID uint   `json:"category_id"`
+	Name       string `json:"name"`
+	ImageCount int64  `json:"image_count"`
+}
+
+// UserCategoryImageCount 사용자별, 카테고리별 이미지 수
+type UserCategoryImageCount struct {
+	UserID     uint   `json:"user_id"`
+	CategoryID uint   `json:"category_id"`
+	Name       string `json:"name"`
+	ImageCount int64  `json:"image_count"`
+}
+
+// DailyCategoryUpload 날짜별, 카테고리별 업로드 수
+type DailyCategoryUpload struct {
+	Date       string `json:"date"` // YYYY-MM-DD
+	CategoryID uint   `json:"category_id"`
+	Name       string `json:"name"`
+	ImageCount int64  `json:"image_count"`
+}
+
+// CategoryStats 카테고리 통계 조회 결과
+type CategoryStats struct {
+	Categories   []CategoryImageCount     `json:"categories"`
+	Users        []UserCategoryImageCount `json:"users"`
+	DailyUploads []DailyCategoryUpload    `json:"daily_uploads"`
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+const (
+	ExportStatusPending   = "PENDING"   // 대기 중
+	ExportStatusRunning   = "RUNNING"   // 압축 파일 만드는 중
+	ExportStatusCompleted = "COMPLETED" // 완료, 보관 기간 동안 다운로드 가능
+	ExportStatusFailed    = "FAILED"
+	ExportStatusExpired   = "EXPIRED" // 보관 기간이 지나 압축 파일 삭제됨
+)
+
+// ExportJob 사용자의 프로필, 이미지, 메타데이터, 카테고리를 압축 파일로 내보내는 작업
+type ExportJob struct {
+	ID                uint   `gorm:"primaryKey;autoIncrement"`
+	UserID            uint   `gorm:"not null;index"`
+	Status            string `gorm:"size:10;not null;index"`
+	IncludeRenditions bool   `gorm:"not null;default:false"` // 썸네일 포함 여부
+	FilePath          string `json:"-"`
+	Size              int64  `gorm:"not null;default:0"` // 압축 파일 크기
+	ImageCount        int    `gorm:"not null;default:0"`
+	Error             string // 실패 사유
+	DownloadURL       string `gorm:"-"` // 완료된 작업의 서명된 다운로드 URL
+	CompletedAt       *time.Time
+	ExpiresAt         *time.Time // 이 시각이 지나면 압축 파일 삭제
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// ExportProfile 내보내는 압축 파일에 담을 사용자 정보, 비밀번호 해시는 제외
+type ExportProfile struct {
+	ID        uint      `json:"id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
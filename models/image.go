@@ -6,12 +6,18 @@ import (
 )
 
 type Image struct {
-	ID            uint      `gorm:"primaryKey;autoIncrement"`
-	FileName      string    `gorm:"not null"`       // 원본 파일명
-	FilePath      string    `gorm:"not null"`       // 서버 저장 경로
-	ThumbnailPath string    `gorm:"not null"`       // 썸네일 경로
-	UploadDate    time.Time `gorm:"autoCreateTime"` // 업로드된 날짜
-	Description   string    // 설명
-	UserID        uint      // 업로드한 사용자 ID
-	gorm.Model
+	ID            uint       `gorm:"primaryKey;autoIncrement"`
+	FileName      string     `gorm:"not null"`          // 원본 파일명
+	FilePath      string     `gorm:"not null" json:"-"` // 서버 저장 경로, 응답에는 노출하지 않음
+	ThumbnailPath string     `gorm:"not null" json:"-"` // 썸네일 경로, 응답에는 노출하지 않음
+	ContentHash   string     `gorm:"size:64" json:"-"`  // 원본 파일의 SHA-256 해시, ETag로 사용
+	UploadDate    time.Time  `gorm:"autoCreateTime"`    // 업로드된 날짜
+	CapturedAt    *time.Time // 촬영 날짜, 일괄 가져오기의 사이드카에 있을 때만 저장
+	Description   string     // 설명
+	UserID        uint       // 업로드한 사용자 ID
+	URL           string     `gorm:"-"` // 원본 이미지의 서명된 URL, 컨트롤러가 응답할 때 설정
+	ThumbnailURL  string     `gorm:"-"` // 썸네일의 서명된 URL
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
 }
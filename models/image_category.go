@@ -2,9 +2,10 @@ package models
 
 import "time"
 
+// ImageCategory 이미지-카테고리 매핑, (image_id, category_id) 복합 기본키로 중복 매핑을 막음
 type ImageCategory struct {
-	ImageID    uint `gorm:"column:image_id"`
-	CategoryID uint `gorm:"column:category_id"`
+	ImageID    uint `gorm:"column:image_id;primaryKey;autoIncrement:false"`
+	CategoryID uint `gorm:"column:category_id;primaryKey;autoIncrement:false"`
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
@@ -12,3 +13,10 @@ type ImageCategory struct {
 func (ImageCategory) TableName() string {
 	return "image_categories"
 }
+
+// ImageCategoryName 이미지별 카테고리명, 여러 이미지의 카테고리를 한 번에 조회할 때 사용
+type ImageCategoryName struct {
+	ImageID    uint
+	CategoryID uint
+	Name       string
+}
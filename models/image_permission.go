@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+const (
+	ImageRoleViewer = "VIEWER" // 이미지와 카테고리, 태그 조회 가능
+	ImageRoleEditor = "EDITOR" // 조회와 함께 카테고리, 태그 수정 가능
+)
+
+// ImagePermission 이미지 소유자가 다른 사용자에게 부여한 권한
+type ImagePermission struct {
+	ImageID   uint   `gorm:"column:image_id;primaryKey;autoIncrement:false"`
+	UserID    uint   `gorm:"column:user_id;primaryKey;autoIncrement:false"` // 권한을 받은 사용자 ID
+	Role      string `gorm:"size:10;not null"`
+	GrantedBy uint   `gorm:"not null"` // 권한을 부여한 사용자 ID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (ImagePermission) TableName() string {
+	return "image_permissions"
+}
+
+// SharedImage 다른 사용자에게 공유받은 이미지와 부여받은 권한
+type SharedImage struct {
+	Image
+	Role string
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// ImageShare 로그인 없이 이미지를 볼 수 있는 공개 공유 링크
+// 토큰 원문은 생성 시 한 번만 응답하고 DB에는 SHA-256 해시만 저장
+type ImageShare struct {
+	ID           uint       `gorm:"primaryKey;autoIncrement"`
+	ImageID      uint       `gorm:"not null;index"`
+	UserID       uint       `gorm:"not null"` // 공유 링크를 만든 사용자 ID
+	TokenHash    string     `gorm:"size:64;unique;not null" json:"-"`
+	PasswordHash string     `json:"-"` // 비밀번호가 없으면 빈 문자열
+	HasPassword  bool       `gorm:"-"`
+	ExpiresAt    *time.Time // nil이면 만료되지 않음
+	MaxViews     int        `gorm:"not null;default:0"` // 0이면 조회 수 제한 없음
+	ViewCount    int        `gorm:"not null;default:0"`
+	RevokedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// AfterFind 비밀번호 해시 대신 비밀번호 설정 여부만 응답에 노출
+func (s *ImageShare) AfterFind(*gorm.DB) error {
+	s.HasPassword = s.PasswordHash != ""
+	return nil
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type ImageTag struct {
+	ImageID   uint `gorm:"column:image_id;primaryKey;autoIncrement:false"`
+	TagID     uint `gorm:"column:tag_id;primaryKey;autoIncrement:false"`
+	CreatedAt time.Time
+}
+
+func (ImageTag) TableName() string {
+	return "image_tags"
+}
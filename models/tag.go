@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// Tag 사용자가 자유롭게 붙이는 해시태그
+type Tag struct {
+	ID        uint   `gorm:"primaryKey;autoIncrement"`
+	Name      string `gorm:"size:50;unique;not null"` // 정규화된 태그명(소문자, '#' 제외)
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
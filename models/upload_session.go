@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// UploadSession tus 프로토콜로 여러 번에 나눠 업로드 중인 파일
+// 업로드된 크기(Offset)는 저장소의 임시 파일 크기를 기준으로 하고, DB에는 마지막으로 기록한 값을 저장
+type UploadSession struct {
+	ID               string    `gorm:"primaryKey;size:32"`
+	UserID           uint      `gorm:"not null;index"`
+	FileName         string    `gorm:"not null"`
+	Description      string    // 설명
+	Categories       string    // 쉼표로 구분한 카테고리명
+	StrictCategories bool      `gorm:"not null;default:false"`
+	Length           int64     `gorm:"not null"`                                // 전체 파일 크기
+	Offset           int64     `gorm:"column:upload_offset;not null;default:0"` // OFFSET은 예약어라 컬럼명을 따로 지정
+	ExpiresAt        time.Time `gorm:"not null;index"`                          // 이 시각까지 업로드가 완료되지 않으면 삭제
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
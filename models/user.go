@@ -2,12 +2,15 @@ package models
 
 import (
 	"gorm.io/gorm"
+	"time"
 )
 
 type User struct {
-	ID       uint   `gorm:"primaryKey;autoIncrement"`
-	Email    string `gorm:"unique;not null"`
-	Password string `gorm:"not null"`
-	Role     string `gorm:"type:enum('USER', 'ADMIN');default:'USER'"`
-	gorm.Model
+	ID        uint   `gorm:"primaryKey;autoIncrement"`
+	Email     string `gorm:"unique;not null"`
+	Password  string `gorm:"not null"`
+	Role      string `gorm:"size:10;not null;default:'USER';check:chk_users_role,role IN ('USER', 'ADMIN')"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
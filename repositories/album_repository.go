@@ -0,0 +1,186 @@
+package repositories
+
+import (
+	"github.com/zeze1004/image-hub-platform/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type albumRepository struct {
+	db *gorm.DB
+}
+
+func NewAlbumRepository(db *gorm.DB) AlbumRepository {
+	return &albumRepository{db: db}
+}
+
+func (r *albumRepository) CreateAlbum(album *models.Album) error {
+	return r.db.Create(album).Error
+}
+
+func (r *albumRepository) GetAlbumByID(albumID uint) (*models.Album, error) {
+	var album models.Album
+	if err := r.db.First(&album, albumID).Error; err != nil {
+		return nil, err
+	}
+	return &album, nil
+}
+
+// GetAlbumsByUserID 사용자의 앨범 목록 조회, 최근에 수정된 앨범부터 정렬
+func (r *albumRepository) GetAlbumsByUserID(userID uint) ([]models.Album, error) {
+	var albums []models.Album
+	err := r.db.Where("user_id = ?", userID).Order("updated_at DESC, id DESC").Find(&albums).Error
+	return albums, err
+}
+
+// UpdateAlbum 앨범 제목과 대표 이미지 수정
+func (r *albumRepository) UpdateAlbum(album *models.Album) error {
+	return r.db.Model(album).Select("Title", "CoverImageID").Updates(album).Error
+}
+
+// DeleteAlbum 앨범과 앨범-이미지 매핑 삭제, 이미지 자체는 삭제하지 않음
+func (r *albumRepository) DeleteAlbum(albumID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("album_id = ?", albumID).Delete(&models.AlbumImage{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Album{}, albumID).Error
+	})
+}
+
+// GetAlbumImages 앨범의 이미지를 앨범 순서대로 조회, limit이 0 이하면 전체 조회
+func (r *albumRepository) GetAlbumImages(albumID uint, limit int) ([]models.Image, error) {
+	var images []models.Image
+	query := r.db.
+		Joins("JOIN album_images ON album_images.image_id = images.id").
+		Where("album_images.album_id = ?", albumID).
+		Order("album_images.position, album_images.image_id")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Find(&images).Error
+	return images, err
+}
+
+// GetAlbumImageIDs 앨범에 담긴 이미지 ID를 앨범 순서대로 조회, 삭제된 이미지는 제외
+func (r *albumRepository) GetAlbumImageIDs(albumID uint) ([]uint, error) {
+	var imageIDs []uint
+	err := r.db.Model(&models.Image{}).
+		Joins("JOIN album_images ON album_images.image_id = images.id").
+		Where("album_images.album_id = ?", albumID).
+		Order("album_images.position, album_images.image_id").
+		Pluck("images.id", &imageIDs).Error
+	return imageIDs, err
+}
+
+// CountImagesByAlbumIDs 앨범별 이미지 수를 한 번에 조회, 이미지가 없는 앨범은 결과에 없음
+func (r *albumRepository) CountImagesByAlbumIDs(albumIDs []uint) ([]models.AlbumImageCount, error) {
+	var counts []models.AlbumImageCount
+	if len(albumIDs) == 0 {
+		return counts, nil
+	}
+	err := r.db.Model(&models.Image{}).
+		Select("album_images.album_id, COUNT(*) AS image_count").
+		Joins("JOIN album_images ON album_images.image_id = images.id").
+		Where("album_images.album_id IN ?", albumIDs).
+		Group("album_images.album_id").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// GetAlbumPreviewImageIDs 앨범별로 앞에서부터 limit개의 이미지 ID를 앨범 순서대로 한 번에 조회, 삭제된 이미지는 제외
+func (r *albumRepository) GetAlbumPreviewImageIDs(albumIDs []uint, limit int) ([]models.AlbumImage, error) {
+	var albumImages []models.AlbumImage
+	if len(albumIDs) == 0 {
+		return albumImages, nil
+	}
+	ranked := r.db.Table("album_images").
+		Select("album_images.album_id, album_images.image_id, album_images.position, "+
+			"ROW_NUMBER() OVER (PARTITION BY album_images.album_id ORDER BY album_images.position, album_images.image_id) AS rank_in_album").
+		Joins("JOIN images ON images.id = album_images.image_id AND images.deleted_at IS NULL").
+		Where("album_images.album_id IN ?", albumIDs)
+	err := r.db.Table("(?) AS ranked", ranked).
+		Select("album_id, image_id, position").
+		Where("rank_in_album <= ?", limit).
+		Order("album_id, position, image_id").
+		Scan(&albumImages).Error
+	return albumImages, err
+}
+
+// AddImagesToAlbum 앨범 마지막에 이미지 추가, 이미 담긴 이미지는 무시
+// 동시에 추가하는 요청이 같은 MAX(position)을 읽지 않도록 앨범 행을 잠근 뒤 다음 순서를 계산
+func (r *albumRepository) AddImagesToAlbum(albumID uint, imageIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockAlbum(tx, albumID); err != nil {
+			return err
+		}
+		var maxPosition struct{ Position *int }
+		if err := tx.Model(&models.AlbumImage{}).
+			Select("MAX(position) AS position").
+			Where("album_id = ?", albumID).
+			Scan(&maxPosition).Error; err != nil {
+			return err
+		}
+		next := 0
+		if maxPosition.Position != nil {
+			next = *maxPosition.Position + 1
+		}
+
+		albumImages := make([]models.AlbumImage, 0, len(imageIDs))
+		for i, imageID := range imageIDs {
+			albumImages = append(albumImages, models.AlbumImage{AlbumID: albumID, ImageID: imageID, Position: next + i})
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&albumImages).Error; err != nil {
+			return err
+		}
+		return touchAlbum(tx, albumID)
+	})
+}
+
+// RemoveImageFromAlbum 앨범에서 이미지 제거, 대표 이미지였다면 대표 이미지도 해제
+func (r *albumRepository) RemoveImageFromAlbum(albumID, imageID uint) (int64, error) {
+	var removed int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("album_id = ? AND image_id = ?", albumID, imageID).Delete(&models.AlbumImage{})
+		if result.Error != nil {
+			return result.Error
+		}
+		removed = result.RowsAffected
+		if err := tx.Model(&models.Album{}).
+			Where("id = ? AND cover_image_id = ?", albumID, imageID).
+			Update("cover_image_id", nil).Error; err != nil {
+			return err
+		}
+		return touchAlbum(tx, albumID)
+	})
+	return removed, err
+}
+
+// ReorderAlbumImages imageIDs 순서대로 앨범 이미지의 순서 변경
+func (r *albumRepository) ReorderAlbumImages(albumID uint, imageIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockAlbum(tx, albumID); err != nil {
+			return err
+		}
+		for position, imageID := range imageIDs {
+			if err := tx.Model(&models.AlbumImage{}).
+				Where("album_id = ? AND image_id = ?", albumID, imageID).
+				Update("position", position).Error; err != nil {
+				return err
+			}
+		}
+		return touchAlbum(tx, albumID)
+	})
+}
+
+// lockAlbum 앨범 이미지 순서를 바꾸는 트랜잭션끼리 직렬화되도록 앨범 행을 잠금
+func lockAlbum(tx *gorm.DB, albumID uint) error {
+	var album models.Album
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&album, albumID).Error
+}
+
+// touchAlbum 앨범 구성이 바뀌면 앨범 수정 시간 갱신
+func touchAlbum(tx *gorm.DB, albumID uint) error {
+	return tx.Model(&models.Album{}).Where("id = ?", albumID).Update("updated_at", time.Now()).Error
+}
@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"github.com/zeze1004/image-hub-platform/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+type bulkImportRepository struct {
+	db *gorm.DB
+}
+
+func NewBulkImportRepository(db *gorm.DB) BulkImportRepository {
+	return &bulkImportRepository{db: db}
+}
+
+func (r *bulkImportRepository) CreateBulkImportJob(job *models.BulkImportJob) error {
+	return r.db.Create(job).Error
+}
+
+func (r *bulkImportRepository) GetBulkImportJobByID(jobID uint) (*models.BulkImportJob, error) {
+	var job models.BulkImportJob
+	if err := r.db.First(&job, jobID).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// GetBulkImportJobsByStatus 상태가 status인 작업을 요청 순서대로 조회
+func (r *bulkImportRepository) GetBulkImportJobsByStatus(status string) ([]models.BulkImportJob, error) {
+	var jobs []models.BulkImportJob
+	err := r.db.Where("status = ?", status).Order("id ASC").Find(&jobs).Error
+	return jobs, err
+}
+
+func (r *bulkImportRepository) UpdateBulkImportJob(job *models.BulkImportJob) error {
+	return r.db.Save(job).Error
+}
+
+// GetExpiredBulkImportJobs before 이전에 보관 기간이 끝난 완료된 작업 조회
+func (r *bulkImportRepository) GetExpiredBulkImportJobs(before time.Time) ([]models.BulkImportJob, error) {
+	var jobs []models.BulkImportJob
+	err := r.db.Where("status = ? AND expires_at < ?", models.BulkImportJobStatusCompleted, before).Find(&jobs).Error
+	return jobs, err
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"github.com/zeze1004/image-hub-platform/models"
 	"gorm.io/gorm"
+	"time"
 )
 
 type categoryRepository struct {
@@ -21,6 +22,15 @@ func (r *categoryRepository) GetCategoriesByName(names []string) ([]models.Categ
 	return categories, nil
 }
 
+// GetCategoryAliases 별칭 목록에 해당하는 카테고리 별칭 조회
+func (r *categoryRepository) GetCategoryAliases(aliases []string) ([]models.CategoryAlias, error) {
+	var categoryAliases []models.CategoryAlias
+	if err := r.db.Preload("Category").Where("alias IN ?", aliases).Find(&categoryAliases).Error; err != nil {
+		return nil, err
+	}
+	return categoryAliases, nil
+}
+
 // GetCategoriesByImageID 특정 이미지에 속한 카테고리 조회
 func (r *categoryRepository) GetCategoriesByImageID(imageID uint) ([]models.Category, error) {
 	var categories []models.Category
@@ -33,6 +43,30 @@ func (r *categoryRepository) GetCategoriesByImageID(imageID uint) ([]models.Cate
 	return categories, err
 }
 
+// GetCategoryNamesByImageIDs 여러 이미지의 카테고리명을 한 번에 조회
+// IN 절에 넣을 수 있는 값의 수가 DB마다 제한이 있으므로 나눠서 조회
+func (r *categoryRepository) GetCategoryNamesByImageIDs(imageIDs []uint) ([]models.ImageCategoryName, error) {
+	const chunkSize = 1000
+
+	var names []models.ImageCategoryName
+	for start := 0; start < len(imageIDs); start += chunkSize {
+		end := min(start+chunkSize, len(imageIDs))
+		var chunk []models.ImageCategoryName
+		err := r.db.
+			Table("image_categories").
+			Select("image_categories.image_id, image_categories.category_id, categories.name").
+			Joins("JOIN categories ON categories.id = image_categories.category_id").
+			Where("image_categories.image_id IN ?", imageIDs[start:end]).
+			Order("image_categories.image_id, categories.id").
+			Scan(&chunk).Error
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, chunk...)
+	}
+	return names, nil
+}
+
 // GetImagesByCategoryID 특정 카테고리에 속한 이미지 조회
 func (r *categoryRepository) GetImagesByCategoryID(categoryID uint) ([]models.Image, error) {
 	var images []models.Image
@@ -56,3 +90,79 @@ func (r *categoryRepository) GetImagesByCategoryIDAndUserID(categoryID, userID u
 		Find(&images).Error
 	return images, err
 }
+
+// CountImagesByCategory 카테고리별 이미지 수 집계, 이미지가 없는 카테고리도 0으로 포함
+// userID가 0이 아니면 해당 사용자의 이미지만 집계
+func (r *categoryRepository) CountImagesByCategory(userID uint) ([]models.CategoryImageCount, error) {
+	imageJoin := "LEFT JOIN images ON images.id = image_categories.image_id AND images.deleted_at IS NULL"
+	args := []interface{}{}
+	if userID != 0 {
+		imageJoin += " AND images.user_id = ?"
+		args = append(args, userID)
+	}
+
+	var counts []models.CategoryImageCount
+	err := r.db.
+		Table("categories").
+		Select("categories.id AS category_id, categories.name AS name, COUNT(images.id) AS image_count").
+		Joins("LEFT JOIN image_categories ON image_categories.category_id = categories.id").
+		Joins(imageJoin, args...).
+		Where("categories.deleted_at IS NULL").
+		Group("categories.id, categories.name").
+		Order("categories.id").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// CountImagesByUserAndCategory 사용자별, 카테고리별 이미지 수 집계
+func (r *categoryRepository) CountImagesByUserAndCategory(userID uint) ([]models.UserCategoryImageCount, error) {
+	query := r.db.
+		Table("image_categories").
+		Select("images.user_id AS user_id, categories.id AS category_id, categories.name AS name, COUNT(*) AS image_count").
+		Joins("JOIN images ON images.id = image_categories.image_id").
+		Joins("JOIN categories ON categories.id = image_categories.category_id").
+		Where("images.deleted_at IS NULL")
+	if userID != 0 {
+		query = query.Where("images.user_id = ?", userID)
+	}
+
+	var counts []models.UserCategoryImageCount
+	err := query.
+		Group("images.user_id, categories.id, categories.name").
+		Order("images.user_id, categories.id").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// CountDailyUploadsByCategory [from, to) 기간 동안 날짜별, 카테고리별 업로드 수 집계
+func (r *categoryRepository) CountDailyUploadsByCategory(from, to time.Time, userID uint) ([]models.DailyCategoryUpload, error) {
+	date := dateExpr(r.db, "images.upload_date")
+	query := r.db.
+		Table("image_categories").
+		Select(date+" AS date, categories.id AS category_id, categories.name AS name, COUNT(*) AS image_count").
+		Joins("JOIN images ON images.id = image_categories.image_id").
+		Joins("JOIN categories ON categories.id = image_categories.category_id").
+		Where("images.deleted_at IS NULL AND images.upload_date >= ? AND images.upload_date < ?", from, to)
+	if userID != 0 {
+		query = query.Where("images.user_id = ?", userID)
+	}
+
+	var uploads []models.DailyCategoryUpload
+	err := query.
+		Group(date + ", categories.id, categories.name").
+		Order("date, categories.id").
+		Scan(&uploads).Error
+	return uploads, err
+}
+
+// dateExpr 시각 컬럼을 YYYY-MM-DD 문자열로 바꾸는 DB별 SQL 식
+func dateExpr(db *gorm.DB, column string) string {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return "TO_CHAR(" + column + ", 'YYYY-MM-DD')"
+	case "sqlite":
+		return "strftime('%Y-%m-%d', " + column + ")"
+	default:
+		return "DATE_FORMAT(" + column + ", '%Y-%m-%d')"
+	}
+}
@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"github.com/zeze1004/image-hub-platform/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type exportRepository struct {
+	db *gorm.DB
+}
+
+func NewExportRepository(db *gorm.DB) ExportRepository {
+	return &exportRepository{db: db}
+}
+
+// CreateExportJobIfIdle 사용자에게 대기 중이거나 진행 중인 작업이 없을 때만 job 생성
+// 사용자 행을 잠근 트랜잭션 안에서 확인하고 만들므로 동시에 요청해도 작업은 하나만 만들어짐
+// 이미 진행 중인 작업이 있으면 만들지 않고 그 작업을 반환
+// SQLite는 쓰기 트랜잭션이 DB 전체를 잠그므로 잠금 절을 생략
+func (r *exportRepository) CreateExportJobIfIdle(job *models.ExportJob) (*models.ExportJob, error) {
+	var active *models.ExportJob
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var user models.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&user, job.UserID).Error; err != nil {
+			return err
+		}
+
+		var jobs []models.ExportJob
+		if err := tx.Where("user_id = ? AND status IN ?", job.UserID, []string{models.ExportStatusPending, models.ExportStatusRunning}).
+			Order("id").
+			Limit(1).
+			Find(&jobs).Error; err != nil {
+			return err
+		}
+		if len(jobs) > 0 {
+			active = &jobs[0]
+			return nil
+		}
+		return tx.Create(job).Error
+	})
+	return active, err
+}
+
+func (r *exportRepository) GetExportJobByID(jobID uint) (*models.ExportJob, error) {
+	var job models.ExportJob
+	if err := r.db.First(&job, jobID).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// GetExportJobsByUserID 사용자의 내보내기 작업을 최신순으로 조회
+func (r *exportRepository) GetExportJobsByUserID(userID uint) ([]models.ExportJob, error) {
+	var jobs []models.ExportJob
+	err := r.db.Where("user_id = ?", userID).Order("id DESC").Find(&jobs).Error
+	return jobs, err
+}
+
+// GetExportJobsByStatus 상태가 status인 작업을 요청 순서대로 조회
+func (r *exportRepository) GetExportJobsByStatus(status string) ([]models.ExportJob, error) {
+	var jobs []models.ExportJob
+	err := r.db.Where("status = ?", status).Order("id ASC").Find(&jobs).Error
+	return jobs, err
+}
+
+func (r *exportRepository) UpdateExportJob(job *models.ExportJob) error {
+	return r.db.Save(job).Error
+}
+
+// GetExpiredExportJobs before 이전에 보관 기간이 끝난 완료된 작업 조회
+func (r *exportRepository) GetExpiredExportJobs(before time.Time) ([]models.ExportJob, error) {
+	var jobs []models.ExportJob
+	err := r.db.Where("status = ? AND expires_at < ?", models.ExportStatusCompleted, before).Find(&jobs).Error
+	return jobs, err
+}
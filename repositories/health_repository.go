@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"context"
+	"github.com/zeze1004/image-hub-platform/migrations"
+	"gorm.io/gorm"
+)
+
+type healthRepository struct {
+	db *gorm.DB
+}
+
+func NewHealthRepository(db *gorm.DB) HealthRepository {
+	return &healthRepository{db: db}
+}
+
+// Ping DB 연결 확인
+func (r *healthRepository) Ping(ctx context.Context) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// GetPendingMigrations 아직 적용하지 않은 스키마 마이그레이션 조회, 시드 데이터 마이그레이션은 확인하지 않음
+func (r *healthRepository) GetPendingMigrations(ctx context.Context) ([]migrations.Migration, error) {
+	return migrations.NewMigrator(r.db.WithContext(ctx), migrations.Schema()).Pending()
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"github.com/zeze1004/image-hub-platform/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type imageCategoryRepository struct {
@@ -33,12 +34,17 @@ func (r *imageCategoryRepository) GetCategoriesByImageID(imageID uint) ([]models
 	return categories, err
 }
 
+// LockImage 트랜잭션이 끝날 때까지 이미지 행을 잠금 (SELECT ... FOR UPDATE), 트랜잭션 안에서만 의미가 있음
+// SQLite는 쓰기 트랜잭션이 DB 전체를 잠그므로 잠금 절을 생략
+func (r *imageCategoryRepository) LockImage(imageID uint) error {
+	var image models.Image
+	return r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&image, imageID).Error
+}
+
 // AddCategoryToImage 이미지에 카테고리 추가
 func (r *imageCategoryRepository) AddCategoryToImage(imageID, categoryID uint) error {
-	return r.db.Exec(
-		"INSERT INTO image_categories (image_id, category_id) VALUES (?, ?) ON DUPLICATE KEY UPDATE image_id=image_id",
-		imageID, categoryID,
-	).Error
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.ImageCategory{ImageID: imageID, CategoryID: categoryID}).Error
 }
 
 // RemoveCategoryFromImage 이미지에서 카테고리 제거
@@ -46,3 +52,15 @@ func (r *imageCategoryRepository) RemoveCategoryFromImage(imageID, categoryID ui
 	return r.db.Where("image_id = ? AND category_id = ?", imageID, categoryID).
 		Delete(&models.ImageCategory{}).Error
 }
+
+// RemoveAllCategoriesFromImage 이미지의 모든 카테고리 제거
+func (r *imageCategoryRepository) RemoveAllCategoriesFromImage(imageID uint) error {
+	return r.db.Where("image_id = ?", imageID).Delete(&models.ImageCategory{}).Error
+}
+
+// Transaction fn 안의 모든 작업을 하나의 트랜잭션으로 실행, fn이 에러를 반환하면 롤백
+func (r *imageCategoryRepository) Transaction(fn func(txRepo ImageCategoryRepository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&imageCategoryRepository{db: tx})
+	})
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"github.com/zeze1004/image-hub-platform/models"
 	"gorm.io/gorm"
+	"time"
 )
 
 type imageRepository struct {
@@ -13,8 +14,21 @@ func NewImageRepository(db *gorm.DB) ImageRepository {
 	return &imageRepository{db: db}
 }
 
-func (r *imageRepository) CreateImageMetaData(image *models.Image) error {
-	return r.db.Create(image).Error
+// CreateImageMetaData 이미지 메타데이터와 카테고리 매핑을 한 트랜잭션으로 저장
+func (r *imageRepository) CreateImageMetaData(image *models.Image, categoryIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(image).Error; err != nil {
+			return err
+		}
+		if len(categoryIDs) == 0 {
+			return nil
+		}
+		imageCategories := make([]models.ImageCategory, 0, len(categoryIDs))
+		for _, categoryID := range categoryIDs {
+			imageCategories = append(imageCategories, models.ImageCategory{ImageID: image.ID, CategoryID: categoryID})
+		}
+		return tx.Create(&imageCategories).Error
+	})
 }
 
 func (r *imageRepository) GetImageByID(id uint) (*models.Image, error) {
@@ -25,6 +39,22 @@ func (r *imageRepository) GetImageByID(id uint) (*models.Image, error) {
 	return &image, nil
 }
 
+// GetImagesByIDs ID 목록의 이미지를 한 번에 조회, 없는 이미지는 결과에서 빠지고 순서는 보장하지 않음
+func (r *imageRepository) GetImagesByIDs(ids []uint) ([]models.Image, error) {
+	const chunkSize = 1000
+
+	var images []models.Image
+	for start := 0; start < len(ids); start += chunkSize {
+		end := min(start+chunkSize, len(ids))
+		var chunk []models.Image
+		if err := r.db.Where("id IN ?", ids[start:end]).Find(&chunk).Error; err != nil {
+			return nil, err
+		}
+		images = append(images, chunk...)
+	}
+	return images, nil
+}
+
 func (r *imageRepository) GetImagesByUserID(userID uint) ([]models.Image, error) {
 	var images []models.Image
 	err := r.db.Where("user_id = ?", userID).Find(&images).Error
@@ -38,6 +68,29 @@ func (r *imageRepository) GetAllImages() ([]models.Image, error) {
 	return images, err
 }
 
+// GetArchiveImages 압축할 이미지 조회, userID나 categoryID가 0이면 해당 조건은 무시
+// 업로드 날짜가 [from, to) 범위인 이미지만 최대 limit개 조회
+func (r *imageRepository) GetArchiveImages(userID, categoryID uint, from, to *time.Time, limit int) ([]models.Image, error) {
+	query := r.db.Model(&models.Image{})
+	if userID != 0 {
+		query = query.Where("images.user_id = ?", userID)
+	}
+	if categoryID != 0 {
+		query = query.Joins("JOIN image_categories ON image_categories.image_id = images.id").
+			Where("image_categories.category_id = ?", categoryID)
+	}
+	if from != nil {
+		query = query.Where("images.upload_date >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("images.upload_date < ?", *to)
+	}
+
+	var images []models.Image
+	err := query.Order("images.id").Limit(limit).Find(&images).Error
+	return images, err
+}
+
 // DeleteImage - 특정 이미지 삭제
 func (r *imageRepository) DeleteImage(imageID uint) error {
 	return r.db.Delete(&models.Image{}, imageID).Error
@@ -47,3 +100,25 @@ func (r *imageRepository) DeleteImage(imageID uint) error {
 func (r *imageRepository) DeleteImagesByUserID(userID uint) error {
 	return r.db.Where("user_id = ?", userID).Delete(&models.Image{}).Error
 }
+
+// FindImageByUserIDAndContentHash - 사용자의 이미지 중 원본 해시가 같은 이미지 조회, 없으면 nil 반환
+func (r *imageRepository) FindImageByUserIDAndContentHash(userID uint, contentHash string) (*models.Image, error) {
+	var images []models.Image
+	if err := r.db.Where("user_id = ? AND content_hash = ?", userID, contentHash).Limit(1).Find(&images).Error; err != nil {
+		return nil, err
+	}
+	if len(images) == 0 {
+		return nil, nil
+	}
+	return &images[0], nil
+}
+
+// UpdateImageContentHash - 이미지의 원본 해시 저장, 파일이 바뀐 것이 아니므로 수정 시간(Last-Modified)은 갱신하지 않음
+func (r *imageRepository) UpdateImageContentHash(imageID uint, contentHash string) error {
+	return r.db.Model(&models.Image{}).Where("id = ?", imageID).UpdateColumn("content_hash", contentHash).Error
+}
+
+// UpdateImageCapturedAt - 이미지의 촬영 날짜 수정
+func (r *imageRepository) UpdateImageCapturedAt(imageID uint, capturedAt time.Time) error {
+	return r.db.Model(&models.Image{}).Where("id = ?", imageID).Update("captured_at", capturedAt).Error
+}
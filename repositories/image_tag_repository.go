@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"github.com/zeze1004/image-hub-platform/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type imageTagRepository struct {
+	db *gorm.DB
+}
+
+func NewImageTagRepository(db *gorm.DB) ImageTagRepository {
+	return &imageTagRepository{db: db}
+}
+
+// AddTagsToImage 이미지에 태그 추가, 이미 붙은 태그는 무시
+func (r *imageTagRepository) AddTagsToImage(imageID uint, tagIDs []uint) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	imageTags := make([]models.ImageTag, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		imageTags = append(imageTags, models.ImageTag{ImageID: imageID, TagID: tagID})
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&imageTags).Error
+}
+
+// RemoveTagFromImage 이미지에서 태그 제거, 제거된 행 수 반환
+func (r *imageTagRepository) RemoveTagFromImage(imageID, tagID uint) (int64, error) {
+	result := r.db.Where("image_id = ? AND tag_id = ?", imageID, tagID).Delete(&models.ImageTag{})
+	return result.RowsAffected, result.Error
+}
+
+// LockImage 트랜잭션이 끝날 때까지 이미지 행을 잠금 (SELECT ... FOR UPDATE), 트랜잭션 안에서만 의미가 있음
+// SQLite는 쓰기 트랜잭션이 DB 전체를 잠그므로 잠금 절을 생략
+func (r *imageTagRepository) LockImage(imageID uint) error {
+	var image models.Image
+	return r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&image, imageID).Error
+}
+
+// GetTagIDsByImageID 특정 이미지에 붙은 태그 ID 조회
+func (r *imageTagRepository) GetTagIDsByImageID(imageID uint) ([]uint, error) {
+	var tagIDs []uint
+	err := r.db.Model(&models.ImageTag{}).Where("image_id = ?", imageID).Pluck("tag_id", &tagIDs).Error
+	return tagIDs, err
+}
+
+// Transaction fn 안의 모든 작업을 하나의 트랜잭션으로 실행, fn이 에러를 반환하면 롤백
+func (r *imageTagRepository) Transaction(fn func(txRepo ImageTagRepository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&imageTagRepository{db: tx})
+	})
+}
@@ -1,31 +1,130 @@
 package repositories
 
-import "github.com/zeze1004/image-hub-platform/models"
+import (
+	"context"
+	"github.com/zeze1004/image-hub-platform/migrations"
+	"github.com/zeze1004/image-hub-platform/models"
+	"time"
+)
 
 type UserRepository interface {
 	CreateUser(user *models.User) error
 	GetUserByEmail(email string) (*models.User, error)
+	GetUserByID(userID uint) (*models.User, error)
 }
 
 type ImageRepository interface {
-	CreateImageMetaData(image *models.Image) error
+	CreateImageMetaData(image *models.Image, categoryIDs []uint) error
 	GetImageByID(id uint) (*models.Image, error)
+	GetImagesByIDs(ids []uint) ([]models.Image, error)
 	GetImagesByUserID(userID uint) ([]models.Image, error)
 	GetAllImages() ([]models.Image, error)
+	GetArchiveImages(userID, categoryID uint, from, to *time.Time, limit int) ([]models.Image, error)
 	DeleteImage(imageID uint) error
 	DeleteImagesByUserID(userID uint) error
+	FindImageByUserIDAndContentHash(userID uint, contentHash string) (*models.Image, error)
+	UpdateImageCapturedAt(imageID uint, capturedAt time.Time) error
+	UpdateImageContentHash(imageID uint, contentHash string) error
 }
 
 type CategoryRepository interface {
 	GetCategoriesByName(names []string) ([]models.Category, error)
+	GetCategoryAliases(aliases []string) ([]models.CategoryAlias, error)
 	GetCategoriesByImageID(imageID uint) ([]models.Category, error)
+	GetCategoryNamesByImageIDs(imageIDs []uint) ([]models.ImageCategoryName, error)
 	GetImagesByCategoryID(categoryID uint) ([]models.Image, error)
 	GetImagesByCategoryIDAndUserID(categoryID, userID uint) ([]models.Image, error)
+	CountImagesByCategory(userID uint) ([]models.CategoryImageCount, error)
+	CountImagesByUserAndCategory(userID uint) ([]models.UserCategoryImageCount, error)
+	CountDailyUploadsByCategory(from, to time.Time, userID uint) ([]models.DailyCategoryUpload, error)
 }
 
 type ImageCategoryRepository interface {
 	AddImageCategory(imageID uint, categoryID uint) error
 	GetCategoriesByImageID(imageID uint) ([]models.Category, error)
+	LockImage(imageID uint) error
 	AddCategoryToImage(imageID, categoryID uint) error
 	RemoveCategoryFromImage(imageID, categoryID uint) error
+	RemoveAllCategoriesFromImage(imageID uint) error
+	Transaction(fn func(txRepo ImageCategoryRepository) error) error
+}
+
+type TagRepository interface {
+	GetOrCreateTagsByName(names []string) ([]models.Tag, error)
+	GetTagsByName(names []string) ([]models.Tag, error)
+	GetTagsByPrefix(prefix string, limit int) ([]models.Tag, error)
+	GetTagsByPrefixAndUserID(prefix string, userID uint, limit int) ([]models.Tag, error)
+	GetTagsByImageID(imageID uint) ([]models.Tag, error)
+	GetImagesByTagName(name string) ([]models.Image, error)
+	GetImagesByTagNameAndUserID(name string, userID uint) ([]models.Image, error)
+}
+
+type ImageTagRepository interface {
+	AddTagsToImage(imageID uint, tagIDs []uint) error
+	RemoveTagFromImage(imageID, tagID uint) (int64, error)
+	LockImage(imageID uint) error
+	GetTagIDsByImageID(imageID uint) ([]uint, error)
+	Transaction(fn func(txRepo ImageTagRepository) error) error
+}
+
+type AlbumRepository interface {
+	CreateAlbum(album *models.Album) error
+	GetAlbumByID(albumID uint) (*models.Album, error)
+	GetAlbumsByUserID(userID uint) ([]models.Album, error)
+	UpdateAlbum(album *models.Album) error
+	DeleteAlbum(albumID uint) error
+	GetAlbumImages(albumID uint, limit int) ([]models.Image, error)
+	GetAlbumImageIDs(albumID uint) ([]uint, error)
+	CountImagesByAlbumIDs(albumIDs []uint) ([]models.AlbumImageCount, error)
+	GetAlbumPreviewImageIDs(albumIDs []uint, limit int) ([]models.AlbumImage, error)
+	AddImagesToAlbum(albumID uint, imageIDs []uint) error
+	RemoveImageFromAlbum(albumID, imageID uint) (int64, error)
+	ReorderAlbumImages(albumID uint, imageIDs []uint) error
+}
+
+type ShareRepository interface {
+	CreateShare(share *models.ImageShare) error
+	GetShareByTokenHash(tokenHash string) (*models.ImageShare, error)
+	GetSharesByImageID(imageID uint) ([]models.ImageShare, error)
+	RevokeShare(imageID, shareID uint) (int64, error)
+	IncrementViewCount(shareID uint) (bool, error)
+}
+
+type PermissionRepository interface {
+	GrantPermission(permission *models.ImagePermission) error
+	RevokePermission(imageID, userID uint) (int64, error)
+	GetPermissionRole(imageID, userID uint) (string, error)
+	GetPermissionsByImageIDsAndUserID(imageIDs []uint, userID uint) ([]models.ImagePermission, error)
+	GetPermissionsByImageID(imageID uint) ([]models.ImagePermission, error)
+	GetImagesSharedWithUser(userID uint) ([]models.SharedImage, error)
+}
+
+type UploadRepository interface {
+	CreateUpload(upload *models.UploadSession) error
+	GetUploadByID(uploadID string) (*models.UploadSession, error)
+	UpdateUploadOffset(uploadID string, offset int64, expiresAt time.Time) error
+	DeleteUpload(uploadID string) error
+	GetExpiredUploads(before time.Time) ([]models.UploadSession, error)
+}
+
+type ExportRepository interface {
+	CreateExportJobIfIdle(job *models.ExportJob) (*models.ExportJob, error)
+	GetExportJobByID(jobID uint) (*models.ExportJob, error)
+	GetExportJobsByUserID(userID uint) ([]models.ExportJob, error)
+	GetExportJobsByStatus(status string) ([]models.ExportJob, error)
+	UpdateExportJob(job *models.ExportJob) error
+	GetExpiredExportJobs(before time.Time) ([]models.ExportJob, error)
+}
+
+type BulkImportRepository interface {
+	CreateBulkImportJob(job *models.BulkImportJob) error
+	GetBulkImportJobByID(jobID uint) (*models.BulkImportJob, error)
+	GetBulkImportJobsByStatus(status string) ([]models.BulkImportJob, error)
+	UpdateBulkImportJob(job *models.BulkImportJob) error
+	GetExpiredBulkImportJobs(before time.Time) ([]models.BulkImportJob, error)
+}
+
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	GetPendingMigrations(ctx context.Context) ([]migrations.Migration, error)
 }
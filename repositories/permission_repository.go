@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"github.com/zeze1004/image-hub-platform/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type permissionRepository struct {
+	db *gorm.DB
+}
+
+func NewPermissionRepository(db *gorm.DB) PermissionRepository {
+	return &permissionRepository{db: db}
+}
+
+// GrantPermission 사용자에게 이미지 권한 부여, 이미 권한이 있으면 역할만 변경
+func (r *permissionRepository) GrantPermission(permission *models.ImagePermission) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "image_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "granted_by", "updated_at"}),
+	}).Create(permission).Error
+}
+
+// RevokePermission 사용자의 이미지 권한 회수, 회수된 권한 수 반환
+func (r *permissionRepository) RevokePermission(imageID, userID uint) (int64, error) {
+	result := r.db.Where("image_id = ? AND user_id = ?", imageID, userID).Delete(&models.ImagePermission{})
+	return result.RowsAffected, result.Error
+}
+
+// GetPermissionRole 사용자가 이미지에 부여받은 역할 조회, 권한이 없으면 빈 문자열 반환
+func (r *permissionRepository) GetPermissionRole(imageID, userID uint) (string, error) {
+	var roles []string
+	err := r.db.Model(&models.ImagePermission{}).
+		Where("image_id = ? AND user_id = ?", imageID, userID).
+		Limit(1).
+		Pluck("role", &roles).Error
+	if err != nil || len(roles) == 0 {
+		return "", err
+	}
+	return roles[0], nil
+}
+
+// GetPermissionsByImageIDsAndUserID 사용자가 여러 이미지에 부여받은 권한을 한 번에 조회, 권한이 없는 이미지는 결과에서 빠짐
+func (r *permissionRepository) GetPermissionsByImageIDsAndUserID(imageIDs []uint, userID uint) ([]models.ImagePermission, error) {
+	const chunkSize = 1000
+
+	var permissions []models.ImagePermission
+	for start := 0; start < len(imageIDs); start += chunkSize {
+		end := min(start+chunkSize, len(imageIDs))
+		var chunk []models.ImagePermission
+		if err := r.db.Where("image_id IN ? AND user_id = ?", imageIDs[start:end], userID).Find(&chunk).Error; err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, chunk...)
+	}
+	return permissions, nil
+}
+
+// GetPermissionsByImageID 이미지에 부여된 권한 목록 조회
+func (r *permissionRepository) GetPermissionsByImageID(imageID uint) ([]models.ImagePermission, error) {
+	var permissions []models.ImagePermission
+	err := r.db.Where("image_id = ?", imageID).Order("created_at").Find(&permissions).Error
+	return permissions, err
+}
+
+// GetImagesSharedWithUser 사용자가 권한을 부여받은 이미지 조회
+func (r *permissionRepository) GetImagesSharedWithUser(userID uint) ([]models.SharedImage, error) {
+	var images []models.SharedImage
+	err := r.db.
+		Table("images").
+		Select("images.*, image_permissions.role AS role").
+		Joins("JOIN image_permissions ON image_permissions.image_id = images.id").
+		Where("image_permissions.user_id = ? AND images.deleted_at IS NULL", userID).
+		Order("image_permissions.created_at DESC").
+		Scan(&images).Error
+	return images, err
+}
@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"github.com/zeze1004/image-hub-platform/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+type shareRepository struct {
+	db *gorm.DB
+}
+
+func NewShareRepository(db *gorm.DB) ShareRepository {
+	return &shareRepository{db: db}
+}
+
+func (r *shareRepository) CreateShare(share *models.ImageShare) error {
+	return r.db.Create(share).Error
+}
+
+// GetShareByTokenHash 토큰 해시로 공유 링크 조회
+func (r *shareRepository) GetShareByTokenHash(tokenHash string) (*models.ImageShare, error) {
+	var share models.ImageShare
+	if err := r.db.Where("token_hash = ?", tokenHash).First(&share).Error; err != nil {
+		return nil, err
+	}
+	return &share, nil
+}
+
+// GetSharesByImageID 이미지의 공유 링크 목록 조회, 최근에 만든 링크부터 정렬
+func (r *shareRepository) GetSharesByImageID(imageID uint) ([]models.ImageShare, error) {
+	var shares []models.ImageShare
+	err := r.db.Where("image_id = ?", imageID).Order("id DESC").Find(&shares).Error
+	return shares, err
+}
+
+// RevokeShare 공유 링크 폐기, 폐기된 링크 수 반환
+func (r *shareRepository) RevokeShare(imageID, shareID uint) (int64, error) {
+	result := r.db.Model(&models.ImageShare{}).
+		Where("id = ? AND image_id = ? AND revoked_at IS NULL", shareID, imageID).
+		Update("revoked_at", time.Now())
+	return result.RowsAffected, result.Error
+}
+
+// IncrementViewCount 조회 수 제한을 넘지 않은 경우에만 조회 수 증가, 증가했는지 여부 반환
+// 동시에 여러 요청이 와도 제한을 넘지 않도록 조건부 UPDATE 한 번으로 처리
+func (r *shareRepository) IncrementViewCount(shareID uint) (bool, error) {
+	result := r.db.Model(&models.ImageShare{}).
+		Where("id = ? AND (max_views = 0 OR view_count < max_views)", shareID).
+		Update("view_count", gorm.Expr("view_count + 1"))
+	return result.RowsAffected > 0, result.Error
+}
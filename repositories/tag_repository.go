@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"github.com/zeze1004/image-hub-platform/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+)
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &tagRepository{db: db}
+}
+
+// GetOrCreateTagsByName 태그명으로 태그를 조회하고, 없는 태그는 새로 생성
+func (r *tagRepository) GetOrCreateTagsByName(names []string) ([]models.Tag, error) {
+	if len(names) == 0 {
+		return []models.Tag{}, nil
+	}
+
+	newTags := make([]models.Tag, 0, len(names))
+	for _, name := range names {
+		newTags = append(newTags, models.Tag{Name: name})
+	}
+	// 동시에 같은 태그가 생성되는 경우를 대비해 중복은 무시
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&newTags).Error; err != nil {
+		return nil, err
+	}
+
+	return r.GetTagsByName(names)
+}
+
+// GetTagsByName 태그명 목록으로 태그 조회
+func (r *tagRepository) GetTagsByName(names []string) ([]models.Tag, error) {
+	var tags []models.Tag
+	if err := r.db.Where("name IN ?", names).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// GetTagsByPrefix 접두어로 시작하는 태그 조회(자동완성)
+func (r *tagRepository) GetTagsByPrefix(prefix string, limit int) ([]models.Tag, error) {
+	var tags []models.Tag
+	err := r.db.
+		Where("name LIKE ? ESCAPE '!'", escapeLike(prefix)+"%").
+		Order("name").
+		Limit(limit).
+		Find(&tags).Error
+	return tags, err
+}
+
+// GetTagsByPrefixAndUserID 사용자가 소유하거나 권한을 받은 이미지에 붙은 태그 중 접두어로 시작하는 태그 조회(자동완성)
+func (r *tagRepository) GetTagsByPrefixAndUserID(prefix string, userID uint, limit int) ([]models.Tag, error) {
+	visibleImageIDs := r.db.
+		Model(&models.Image{}).
+		Select("images.id").
+		Where("images.user_id = ? OR images.id IN (?)", userID,
+			r.db.Model(&models.ImagePermission{}).Select("image_id").Where("user_id = ?", userID))
+	var tags []models.Tag
+	err := r.db.
+		Where("name LIKE ? ESCAPE '!'", escapeLike(prefix)+"%").
+		Where("id IN (?)", r.db.Model(&models.ImageTag{}).Select("tag_id").Where("image_id IN (?)", visibleImageIDs)).
+		Order("name").
+		Limit(limit).
+		Find(&tags).Error
+	return tags, err
+}
+
+// GetTagsByImageID 특정 이미지에 붙은 태그 조회
+func (r *tagRepository) GetTagsByImageID(imageID uint) ([]models.Tag, error) {
+	var tags []models.Tag
+	err := r.db.
+		Table("tags").
+		Select("tags.*").
+		Joins("JOIN image_tags ON image_tags.tag_id = tags.id").
+		Where("image_tags.image_id = ?", imageID).
+		Order("tags.name").
+		Find(&tags).Error
+	return tags, err
+}
+
+// GetImagesByTagName 특정 태그가 붙은 이미지 조회
+func (r *tagRepository) GetImagesByTagName(name string) ([]models.Image, error) {
+	var images []models.Image
+	err := r.db.
+		Joins("JOIN image_tags ON image_tags.image_id = images.id").
+		Joins("JOIN tags ON tags.id = image_tags.tag_id").
+		Where("tags.name = ?", name).
+		Find(&images).Error
+	return images, err
+}
+
+// GetImagesByTagNameAndUserID 특정 태그가 붙은 사용자의 이미지 조회
+func (r *tagRepository) GetImagesByTagNameAndUserID(name string, userID uint) ([]models.Image, error) {
+	var images []models.Image
+	err := r.db.
+		Joins("JOIN image_tags ON image_tags.image_id = images.id").
+		Joins("JOIN tags ON tags.id = image_tags.tag_id").
+		Where("tags.name = ? AND images.user_id = ?", name, userID).
+		Find(&images).Error
+	return images, err
+}
+
+// escapeLike LIKE 검색어의 와일드카드 문자를 '!'로 이스케이프
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"github.com/zeze1004/image-hub-platform/models"
+	"gorm.io/gorm"
+	"time"
+)
+
+type uploadRepository struct {
+	db *gorm.DB
+}
+
+func NewUploadRepository(db *gorm.DB) UploadRepository {
+	return &uploadRepository{db: db}
+}
+
+func (r *uploadRepository) CreateUpload(upload *models.UploadSession) error {
+	return r.db.Create(upload).Error
+}
+
+func (r *uploadRepository) GetUploadByID(uploadID string) (*models.UploadSession, error) {
+	var upload models.UploadSession
+	if err := r.db.Where("id = ?", uploadID).First(&upload).Error; err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
+
+// UpdateUploadOffset 업로드된 크기와 만료 시각 갱신
+func (r *uploadRepository) UpdateUploadOffset(uploadID string, offset int64, expiresAt time.Time) error {
+	return r.db.Model(&models.UploadSession{}).
+		Where("id = ?", uploadID).
+		Updates(map[string]interface{}{"upload_offset": offset, "expires_at": expiresAt}).Error
+}
+
+func (r *uploadRepository) DeleteUpload(uploadID string) error {
+	return r.db.Where("id = ?", uploadID).Delete(&models.UploadSession{}).Error
+}
+
+// GetExpiredUploads before 이전에 만료된 업로드 조회
+func (r *uploadRepository) GetExpiredUploads(before time.Time) ([]models.UploadSession, error) {
+	var uploads []models.UploadSession
+	err := r.db.Where("expires_at < ?", before).Find(&uploads).Error
+	return uploads, err
+}
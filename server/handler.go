@@ -0,0 +1,206 @@
+package server
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/initializers"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
+	"github.com/zeze1004/image-hub-platform/metrics"
+	"github.com/zeze1004/image-hub-platform/middlewares"
+	"gorm.io/gorm"
+	"net/http"
+)
+
+// Dependencies 핸들러가 사용하는 외부 자원
+type Dependencies struct {
+	DB        *gorm.DB
+	Lifecycle *lifecycle.Registry // 모듈이 띄우는 백그라운드 작업을 등록, 종료할 때 Shutdown으로 기다림
+}
+
+// NewHandler 모듈을 초기화하고 모든 API 경로를 등록한 핸들러 생성
+// 다른 바이너리에 API를 포함하거나 httptest로 테스트할 때도 이 핸들러를 사용
+func NewHandler(cfg *config.Config, deps Dependencies) http.Handler {
+	db := deps.DB
+
+	// 토큰과 파일 URL 서명은 설정으로 만들어서 주입, 한 프로세스에서 여러 핸들러를 만들어도 서로 설정을 덮어쓰지 않음
+	jwt := initializers.InitJWT(cfg)
+	signer := initializers.InitURLSigner(cfg)
+
+	// 모듈 초기화
+	authController := initializers.InitUserModule(db, jwt)
+	imageController := initializers.InitImageModule(db, cfg, signer, deps.Lifecycle)
+	categoryController := initializers.InitCategoryModule(db)
+	tagController := initializers.InitTagModule(db, signer)
+	albumController := initializers.InitAlbumModule(db, signer)
+	shareController := initializers.InitShareModule(db)
+	permissionController := initializers.InitPermissionModule(db, signer)
+	fileController := initializers.InitFileModule(signer)
+	uploadController := initializers.InitUploadModule(db, cfg, deps.Lifecycle)
+	importController := initializers.InitImportModule(db, cfg, signer)
+	archiveController := initializers.InitArchiveModule(db)
+	exportController := initializers.InitExportModule(db, cfg, signer, deps.Lifecycle)
+	bulkImportController := initializers.InitBulkImportModule(db, cfg, signer, deps.Lifecycle)
+	healthController := initializers.InitHealthModule(db, cfg, deps.Lifecycle)
+
+	// Recovery가 패닉을 500 응답으로 바꾼 뒤에 기록하도록 Metrics를 Recovery보다 먼저 등록
+	r := gin.New()
+	r.Use(gin.Logger(), middlewares.Metrics(), gin.Recovery())
+
+	// 로드밸런서와 배포 도구가 쓰는 상태 확인 API, 인증 없이 접근
+	r.GET("/healthz", healthController.Healthz)
+	r.GET("/readyz", healthController.Readyz) // DB, 저장소, 마이그레이션 확인, 종료 중이면 503
+	r.GET("/version", healthController.Version)
+	r.GET("/metrics", gin.WrapH(metrics.Handler())) // Prometheus 수집 경로, 외부에 노출하지 않도록 네트워크에서 막아야 함
+
+	auth := r.Group("/auth")
+	{
+		auth.POST("/signup", authController.SignUp)
+		auth.POST("/login", authController.Login)
+	}
+
+	// 공유 링크 API, 인증 없이 토큰으로 접근
+	share := r.Group("/s")
+	{
+		share.GET("/:token", shareController.OpenShare)
+		share.GET("/:token/thumbnail", shareController.OpenShareThumbnail)
+		share.POST("/:token", shareController.OpenShare) // 비밀번호를 본문으로 전달
+		share.POST("/:token/thumbnail", shareController.OpenShareThumbnail)
+	}
+
+	// 서명된 파일 URL, JWT 대신 URL의 서명으로 검증
+	r.GET("/files/*filepath", fileController.ServeSignedFile)
+
+	api := r.Group("/api", middlewares.JWTAuthMiddleware(jwt)) // JWT 인증 미들웨어를 타는 API 그룹
+
+	userAPI := api.Group("/user")              // user용 엔드포인트
+	userAPI.Use(middlewares.RequireUserRole()) // user 권한 검증 미들웨어
+	{
+		// 이미지 API
+		imageAPI := userAPI.Group("/images")
+		imageAPI.POST("", imageController.UploadImage)
+		imageAPI.POST("/import", importController.ImportImageFromURL) // URL로 이미지 가져오기
+		imageAPI.POST("/archive", archiveController.CreateArchive)    // 여러 이미지 ZIP 다운로드
+
+		imageAPI.GET("", imageController.GetImagesByUserID)
+		imageAPI.GET("/shared", permissionController.GetSharedImages)                   // 공유받은 이미지 조회
+		imageAPI.GET("/thumbnail/:imageID/", imageController.GetThumbnail)              // 썸네일 조회 엔드포인트
+		imageAPI.GET("/:imageID/categories", categoryController.GetCategoriesByImageID) // 카테고리별 이미지 조회
+		imageAPI.GET("/:imageID/tags", tagController.GetTagsByImageID)                  // 이미지의 태그 조회
+		imageAPI.GET("/:imageID/shares", shareController.GetShares)                     // 이미지의 공유 링크 조회
+		imageAPI.GET("/:imageID/permissions", permissionController.GetPermissions)      // 이미지를 공유한 사용자 조회
+		imageAPI.GET("/:imageID/file", imageController.GetImageFile)                    // 원본 이미지 파일 조회, ?download=1이면 다운로드
+		imageAPI.GET("/:imageID/", imageController.GetImageByID)
+
+		imageAPI.POST("/:imageID/tags", tagController.AddTagsToImage)
+		imageAPI.POST("/:imageID/shares", shareController.CreateShare)               // 공유 링크 생성
+		imageAPI.POST("/:imageID/permissions", permissionController.GrantPermission) // 다른 사용자에게 권한 부여
+
+		imageAPI.DELETE("", imageController.DeleteAllUserImages)
+		imageAPI.DELETE("/:imageID", imageController.DeleteImage)
+		imageAPI.DELETE("/:imageID/tags/:tagName", tagController.RemoveTagFromImage)
+		imageAPI.DELETE("/:imageID/shares/:shareID", shareController.RevokeShare) // 공유 링크 폐기
+		imageAPI.DELETE("/:imageID/permissions/:userID", permissionController.RevokePermission)
+
+		// 이어올리기 업로드 API (tus 프로토콜)
+		uploadAPI := userAPI.Group("/uploads", middlewares.TusResumable())
+
+		uploadAPI.OPTIONS("", uploadController.GetUploadOptions)
+		uploadAPI.POST("", uploadController.CreateUpload)
+		uploadAPI.HEAD("/:uploadID", uploadController.GetUploadOffset)
+		uploadAPI.PATCH("/:uploadID", uploadController.WriteUploadChunk)
+		uploadAPI.DELETE("/:uploadID", uploadController.DeleteUpload)
+
+		// 카테고리 API
+		categoryAPI := userAPI.Group("/categories")
+
+		categoryAPI.GET("/:categoryID/images", imageController.GetImagesByCategoryID)
+		categoryAPI.POST("/bulk", categoryController.BulkUpdateImageCategories) // 여러 이미지 카테고리 일괄 변경
+		categoryAPI.POST("/:categoryID/images/:imageID/", categoryController.AddCategoryToImage)
+		categoryAPI.DELETE("/:categoryID/images/:imageID/", categoryController.RemoveCategoryFromImage)
+
+		// 태그 API
+		tagAPI := userAPI.Group("/tags")
+
+		tagAPI.GET("", tagController.SearchTags) // 태그 자동완성
+		tagAPI.GET("/:tagName/images", tagController.GetImagesByTagName)
+
+		// 앨범 API
+		albumAPI := userAPI.Group("/albums")
+
+		albumAPI.POST("", albumController.CreateAlbum)
+		albumAPI.GET("", albumController.GetAlbums)
+		albumAPI.GET("/:albumID", albumController.GetAlbum)
+		albumAPI.PATCH("/:albumID", albumController.UpdateAlbum) // 앨범 이름 변경, 대표 이미지 지정
+		albumAPI.DELETE("/:albumID", albumController.DeleteAlbum)
+
+		albumAPI.POST("/:albumID/images", albumController.AddImagesToAlbum)
+		albumAPI.PUT("/:albumID/images/order", albumController.ReorderAlbumImages) // 앨범 이미지 순서 변경
+		albumAPI.DELETE("/:albumID/images/:imageID", albumController.RemoveImageFromAlbum)
+
+		// 계정 데이터 내보내기 API
+		exportAPI := userAPI.Group("/export")
+
+		exportAPI.POST("", exportController.RequestExport)
+		exportAPI.GET("", exportController.GetExportJobs)
+		exportAPI.GET("/:jobID", exportController.GetExportJob)
+	}
+
+	adminAPI := api.Group("/admin")              // admin용 엔드포인트
+	adminAPI.Use(middlewares.RequireAdminRole()) // admin 권한 검증 미들웨어
+	{
+		// 이미지 API
+		imageAPI := adminAPI.Group("/images")
+
+		imageAPI.POST(":userID/", imageController.UploadImage)
+		imageAPI.POST("/users/:userID/import", importController.ImportImageFromURL)
+		imageAPI.POST("/archive", archiveController.CreateArchive)
+		imageAPI.POST("/bulk-import", bulkImportController.ImportArchive)      // ZIP과 메타데이터 파일로 일괄 가져오기 요청
+		imageAPI.GET("/bulk-import/:jobID", bulkImportController.GetImportJob) // 일괄 가져오기 진행 상황, 결과 파일 URL
+
+		imageAPI.GET("", imageController.GetAllImagesByAdmin)
+		imageAPI.GET("users/:userID/images", imageController.GetImagesByUserID)
+		imageAPI.GET("/:imageID/", imageController.GetImageByID)
+		imageAPI.GET("/:imageID/file", imageController.GetImageFile)
+
+		imageAPI.DELETE("/users/:userID/images", imageController.DeleteAllUserImages)
+		imageAPI.DELETE("/:imageID/", imageController.DeleteImage)
+		imageAPI.GET("/:imageID/categories", categoryController.GetCategoriesByImageID) // 카테고리별 이미지 조회
+		imageAPI.GET("/:imageID/tags", tagController.GetTagsByImageID)
+		imageAPI.DELETE("/:imageID/tags/:tagName", tagController.RemoveTagFromImage)
+		imageAPI.GET("/:imageID/shares", shareController.GetShares)
+		imageAPI.DELETE("/:imageID/shares/:shareID", shareController.RevokeShare)
+		imageAPI.GET("/:imageID/permissions", permissionController.GetPermissions)
+		imageAPI.DELETE("/:imageID/permissions/:userID", permissionController.RevokePermission)
+
+		// 카테고리 API
+		categoryAPI := adminAPI.Group("/categories")
+
+		categoryAPI.GET("/stats", categoryController.GetCategoryStats) // 카테고리 통계
+		categoryAPI.GET("/:categoryID/images", imageController.GetImagesByCategoryID)
+		categoryAPI.POST("/bulk", categoryController.BulkUpdateImageCategories) // 여러 이미지 카테고리 일괄 변경
+		categoryAPI.POST("/:categoryID/images/:imageID/", categoryController.AddCategoryToImage)
+		categoryAPI.DELETE("/:categoryID/images/:imageID/", categoryController.RemoveCategoryFromImage)
+
+		// 태그 API
+		tagAPI := adminAPI.Group("/tags")
+
+		tagAPI.GET("", tagController.SearchTags)
+		tagAPI.GET("/:tagName/images", tagController.GetImagesByTagName)
+		tagAPI.POST("/images/:imageID", tagController.AddTagsToImage) // POST /images/:userID/ 업로드 경로와 겹치지 않도록 태그 그룹에 등록
+
+		// 앨범 API
+		albumAPI := adminAPI.Group("/albums")
+
+		albumAPI.POST("/users/:userID", albumController.CreateAlbum)
+		albumAPI.GET("/users/:userID", albumController.GetAlbums)
+		albumAPI.GET("/:albumID", albumController.GetAlbum)
+		albumAPI.PATCH("/:albumID", albumController.UpdateAlbum)
+		albumAPI.DELETE("/:albumID", albumController.DeleteAlbum)
+
+		albumAPI.POST("/:albumID/images", albumController.AddImagesToAlbum)
+		albumAPI.PUT("/:albumID/images/order", albumController.ReorderAlbumImages)
+		albumAPI.DELETE("/:albumID/images/:imageID", albumController.RemoveImageFromAlbum)
+	}
+
+	return r
+}
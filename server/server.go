@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/zeze1004/image-hub-platform/config"
+	"log"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	readHeaderTimeout = 10 * time.Second // 요청 헤더를 천천히 보내는 연결이 서버 자원을 잡고 있지 않도록 제한
+	closeGracePeriod  = 5 * time.Second  // 종료 대기 시간이 지나 연결을 끊은 뒤, 처리 중이던 요청이 쓰던 파일을 정리하고 끝날 때까지 기다리는 시간
+)
+
+// ListenAndServe cfg.Addr에서 handler로 요청을 처리하고, ctx가 끝나면 처리 중인 요청을 기다린 뒤 종료
+func ListenAndServe(ctx context.Context, cfg config.ServerConfig, handler http.Handler) error {
+	listener, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		return err
+	}
+	return Serve(ctx, listener, cfg, handler)
+}
+
+// Serve listener로 받은 연결을 handler로 처리, TLS 인증서가 설정되면 HTTPS로 처리
+// ctx가 끝나면 cfg.DrainDelay 동안 요청을 계속 받은 뒤, 새 연결을 받지 않고 cfg.ShutdownTimeout 동안 처리 중인 요청을 기다림, 0이면 끝날 때까지 기다림
+// 그래도 끝나지 않은 요청은 연결을 끊어서 업로드 본문 읽기를 실패시키고, 요청이 임시 파일을 정리하고 끝날 때까지 잠시 기다림
+func Serve(ctx context.Context, listener net.Listener, cfg config.ServerConfig, handler http.Handler) error {
+	var inFlight sync.WaitGroup
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			inFlight.Add(1)
+			defer inFlight.Done()
+			handler.ServeHTTP(w, r)
+		}),
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       time.Duration(cfg.ReadTimeout),
+		WriteTimeout:      time.Duration(cfg.WriteTimeout),
+		IdleTimeout:       time.Duration(cfg.IdleTimeout),
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		if cfg.TLS() {
+			serveErr <- srv.ServeTLS(listener, cfg.TLSCertFile, cfg.TLSKeyFile)
+		} else {
+			serveErr <- srv.Serve(listener)
+		}
+	}()
+	log.Printf("서버를 시작합니다: %s (TLS: %t)", listener.Addr(), cfg.TLS())
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	if cfg.DrainDelay > 0 {
+		log.Printf("로드밸런서가 서버를 뺄 때까지 %s 동안 요청을 계속 받습니다", time.Duration(cfg.DrainDelay))
+		time.Sleep(time.Duration(cfg.DrainDelay))
+	}
+
+	log.Printf("종료 신호를 받아서 처리 중인 요청을 기다립니다 (최대 %s)", time.Duration(cfg.ShutdownTimeout))
+	shutdownCtx := context.Background()
+	if cfg.ShutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		shutdownCtx, cancel = context.WithTimeout(shutdownCtx, time.Duration(cfg.ShutdownTimeout))
+		defer cancel()
+	}
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("종료 대기 시간 안에 끝나지 않은 요청의 연결을 끊습니다: %v", err)
+		srv.Close()
+		waitInFlight(&inFlight, closeGracePeriod)
+		return err
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// waitInFlight 처리 중인 요청이 모두 끝나거나 timeout이 지날 때까지 기다림
+func waitInFlight(inFlight *sync.WaitGroup, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		log.Printf("%s 동안 기다렸지만 끝나지 않은 요청이 있습니다", timeout)
+	}
+}
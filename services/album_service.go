@@ -0,0 +1,313 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxAlbumTitleLength = 100  // 앨범 제목 최대 글자 수
+	maxAlbumImages      = 1000 // 앨범 하나에 담을 수 있는 최대 이미지 수
+	albumThumbnailCount = 4    // 앨범 목록에서 보여줄 미리보기 이미지 수
+)
+
+var (
+	ErrInvalidAlbumRequest = errors.New("잘못된 앨범 요청입니다")
+	ErrAlbumImageNotFound  = errors.New("앨범에 없는 이미지입니다")
+)
+
+type albumService struct {
+	imageRepo repositories.ImageRepository
+	albumRepo repositories.AlbumRepository
+}
+
+func NewAlbumService(imageRepo repositories.ImageRepository, albumRepo repositories.AlbumRepository) AlbumService {
+	return &albumService{imageRepo: imageRepo, albumRepo: albumRepo}
+}
+
+// CreateAlbum ownerID 사용자의 앨범 생성
+func (s *albumService) CreateAlbum(ownerID uint, title string) (*models.Album, error) {
+	title, err := normalizeAlbumTitle(title)
+	if err != nil {
+		return nil, err
+	}
+
+	album := models.Album{UserID: ownerID, Title: title}
+	if err := s.albumRepo.CreateAlbum(&album); err != nil {
+		return nil, fmt.Errorf("앨범을 생성하는데 실패했습니다: %v", err)
+	}
+	return &album, nil
+}
+
+// GetAlbumsByUserID 사용자의 앨범 목록과 앨범별 미리보기 이미지 조회
+func (s *albumService) GetAlbumsByUserID(userID uint) ([]models.AlbumSummary, error) {
+	albums, err := s.albumRepo.GetAlbumsByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("앨범 목록을 가져오는데 실패했습니다: %v", err)
+	}
+
+	if len(albums) == 0 {
+		return []models.AlbumSummary{}, nil
+	}
+
+	// 앨범 수와 상관없이 이미지 수, 미리보기 이미지 ID, 미리보기 이미지를 각각 한 번씩 조회
+	albumIDs := make([]uint, 0, len(albums))
+	for _, album := range albums {
+		albumIDs = append(albumIDs, album.ID)
+	}
+	counts, err := s.albumRepo.CountImagesByAlbumIDs(albumIDs)
+	if err != nil {
+		return nil, fmt.Errorf("앨범의 이미지 수를 가져오는데 실패했습니다: %v", err)
+	}
+	imageCounts := make(map[uint]int64, len(counts))
+	for _, count := range counts {
+		imageCounts[count.AlbumID] = count.ImageCount
+	}
+
+	previews, err := s.albumThumbnails(albums, albumIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]models.AlbumSummary, 0, len(albums))
+	for _, album := range albums {
+		summaries = append(summaries, models.AlbumSummary{Album: album, ImageCount: imageCounts[album.ID], Thumbnails: previews[album.ID]})
+	}
+	return summaries, nil
+}
+
+// GetAlbum 앨범과 앨범의 이미지를 순서대로 조회
+func (s *albumService) GetAlbum(albumID, userID uint, isAdmin bool) (*models.AlbumDetail, error) {
+	album, err := s.getAlbum(albumID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := s.albumRepo.GetAlbumImages(albumID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("앨범의 이미지를 가져오는데 실패했습니다: %v", err)
+	}
+	return &models.AlbumDetail{Album: *album, Images: images}, nil
+}
+
+// UpdateAlbum 앨범 제목 변경 및 대표 이미지 지정, nil인 값은 변경하지 않음
+// coverImageID가 0이면 대표 이미지를 해제하고, 그 외에는 앨범에 담긴 이미지만 대표 이미지로 지정 가능
+func (s *albumService) UpdateAlbum(albumID, userID uint, isAdmin bool, title *string, coverImageID *uint) (*models.Album, error) {
+	album, err := s.getAlbum(albumID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if title != nil {
+		normalized, err := normalizeAlbumTitle(*title)
+		if err != nil {
+			return nil, err
+		}
+		album.Title = normalized
+	}
+
+	if coverImageID != nil {
+		if *coverImageID == 0 {
+			album.CoverImageID = nil
+		} else {
+			if err := s.validateAlbumImage(albumID, *coverImageID); err != nil {
+				return nil, err
+			}
+			id := *coverImageID
+			album.CoverImageID = &id
+		}
+	}
+
+	if err := s.albumRepo.UpdateAlbum(album); err != nil {
+		return nil, fmt.Errorf("앨범을 수정하는데 실패했습니다: %v", err)
+	}
+	return album, nil
+}
+
+// DeleteAlbum 앨범 삭제, 앨범에 담긴 이미지는 삭제되지 않음
+func (s *albumService) DeleteAlbum(albumID, userID uint, isAdmin bool) error {
+	if _, err := s.getAlbum(albumID, userID, isAdmin); err != nil {
+		return err
+	}
+	return s.albumRepo.DeleteAlbum(albumID)
+}
+
+// AddImagesToAlbum 앨범 마지막에 이미지 추가, 앨범 소유자의 이미지만 추가 가능
+func (s *albumService) AddImagesToAlbum(albumID, userID uint, isAdmin bool, imageIDs []uint) error {
+	album, err := s.getAlbum(albumID, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+
+	imageIDs = uniqueIDs(imageIDs)
+	if len(imageIDs) == 0 {
+		return fmt.Errorf("%w: 추가할 이미지가 없습니다", ErrInvalidAlbumRequest)
+	}
+
+	current, err := s.albumRepo.GetAlbumImageIDs(albumID)
+	if err != nil {
+		return fmt.Errorf("앨범의 이미지를 가져오는데 실패했습니다: %v", err)
+	}
+	inAlbum := make(map[uint]struct{}, len(current))
+	for _, imageID := range current {
+		inAlbum[imageID] = struct{}{}
+	}
+
+	var newImageIDs []uint
+	for _, imageID := range imageIDs {
+		if _, ok := inAlbum[imageID]; ok {
+			continue
+		}
+		// 관리자가 요청해도 앨범 소유자의 이미지만 담을 수 있음
+		if err := utils.ValidateImageOwnership(s.imageRepo, imageID, album.UserID); err != nil {
+			return fmt.Errorf("이미지(%d): %v", imageID, err)
+		}
+		newImageIDs = append(newImageIDs, imageID)
+	}
+	if len(current)+len(newImageIDs) > maxAlbumImages {
+		return fmt.Errorf("%w: 앨범에는 이미지를 최대 %d개까지 담을 수 있습니다", ErrInvalidAlbumRequest, maxAlbumImages)
+	}
+	if len(newImageIDs) == 0 {
+		return nil
+	}
+
+	return s.albumRepo.AddImagesToAlbum(albumID, newImageIDs)
+}
+
+// RemoveImageFromAlbum 앨범에서 이미지 제거, 이미지 자체는 삭제되지 않음
+func (s *albumService) RemoveImageFromAlbum(albumID, imageID, userID uint, isAdmin bool) error {
+	if _, err := s.getAlbum(albumID, userID, isAdmin); err != nil {
+		return err
+	}
+
+	removed, err := s.albumRepo.RemoveImageFromAlbum(albumID, imageID)
+	if err != nil {
+		return fmt.Errorf("앨범에서 이미지를 제거하는데 실패했습니다: %v", err)
+	}
+	if removed == 0 {
+		return ErrAlbumImageNotFound
+	}
+	return nil
+}
+
+// ReorderAlbumImages 앨범 이미지 순서 변경, imageIDs는 앨범의 모든 이미지를 원하는 순서로 나열해야 함
+func (s *albumService) ReorderAlbumImages(albumID, userID uint, isAdmin bool, imageIDs []uint) error {
+	if _, err := s.getAlbum(albumID, userID, isAdmin); err != nil {
+		return err
+	}
+
+	current, err := s.albumRepo.GetAlbumImageIDs(albumID)
+	if err != nil {
+		return fmt.Errorf("앨범의 이미지를 가져오는데 실패했습니다: %v", err)
+	}
+	if !isPermutation(current, imageIDs) {
+		return fmt.Errorf("%w: 앨범의 모든 이미지를 중복 없이 나열해야 합니다", ErrInvalidAlbumRequest)
+	}
+
+	return s.albumRepo.ReorderAlbumImages(albumID, imageIDs)
+}
+
+// getAlbum 관리자는 모든 앨범을, 사용자는 자신의 앨범만 조회
+func (s *albumService) getAlbum(albumID, userID uint, isAdmin bool) (*models.Album, error) {
+	if !isAdmin {
+		return utils.ValidateAlbumOwnership(s.albumRepo, albumID, userID)
+	}
+
+	album, err := s.albumRepo.GetAlbumByID(albumID)
+	if err != nil {
+		return nil, fmt.Errorf("앨범을 찾을 수 없습니다: %v", err)
+	}
+	return album, nil
+}
+
+// validateAlbumImage 이미지가 앨범에 담겨 있는지 검증
+func (s *albumService) validateAlbumImage(albumID, imageID uint) error {
+	imageIDs, err := s.albumRepo.GetAlbumImageIDs(albumID)
+	if err != nil {
+		return fmt.Errorf("앨범의 이미지를 가져오는데 실패했습니다: %v", err)
+	}
+	for _, id := range imageIDs {
+		if id == imageID {
+			return nil
+		}
+	}
+	return ErrAlbumImageNotFound
+}
+
+// albumThumbnails 앨범별로 대표 이미지를 맨 앞에 두고 앨범 순서대로 미리보기 이미지 조회
+func (s *albumService) albumThumbnails(albums []models.Album, albumIDs []uint) (map[uint][]models.Image, error) {
+	albumImages, err := s.albumRepo.GetAlbumPreviewImageIDs(albumIDs, albumThumbnailCount)
+	if err != nil {
+		return nil, fmt.Errorf("앨범의 미리보기 이미지를 가져오는데 실패했습니다: %v", err)
+	}
+
+	imageIDs := make([]uint, 0, len(albumImages)+len(albums))
+	for _, albumImage := range albumImages {
+		imageIDs = append(imageIDs, albumImage.ImageID)
+	}
+	for _, album := range albums {
+		if album.CoverImageID != nil {
+			imageIDs = append(imageIDs, *album.CoverImageID)
+		}
+	}
+	images, err := s.imageRepo.GetImagesByIDs(uniqueIDs(imageIDs))
+	if err != nil {
+		return nil, fmt.Errorf("앨범의 미리보기 이미지를 가져오는데 실패했습니다: %v", err)
+	}
+	imagesByID := make(map[uint]models.Image, len(images))
+	for _, image := range images {
+		imagesByID[image.ID] = image
+	}
+
+	thumbnails := make(map[uint][]models.Image, len(albums))
+	for _, album := range albums {
+		thumbnails[album.ID] = []models.Image{}
+		// 대표 이미지가 삭제된 경우 앨범 순서대로 보여줌
+		if album.CoverImageID != nil {
+			if cover, ok := imagesByID[*album.CoverImageID]; ok {
+				thumbnails[album.ID] = append(thumbnails[album.ID], cover)
+			}
+		}
+	}
+	for _, albumImage := range albumImages {
+		image, ok := imagesByID[albumImage.ImageID]
+		current := thumbnails[albumImage.AlbumID]
+		if !ok || len(current) >= albumThumbnailCount || (len(current) > 0 && current[0].ID == image.ID) {
+			continue
+		}
+		thumbnails[albumImage.AlbumID] = append(current, image)
+	}
+	return thumbnails, nil
+}
+
+// normalizeAlbumTitle 앨범 제목 앞뒤 공백 제거 및 길이 검증
+func normalizeAlbumTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || utf8.RuneCountInString(title) > maxAlbumTitleLength {
+		return "", fmt.Errorf("%w: 앨범 제목은 1 ~ %d자여야 합니다", ErrInvalidAlbumRequest, maxAlbumTitleLength)
+	}
+	return title, nil
+}
+
+// isPermutation requested가 current의 모든 ID를 중복 없이 포함하는지 확인
+func isPermutation(current, requested []uint) bool {
+	if len(current) != len(requested) {
+		return false
+	}
+	remaining := make(map[uint]struct{}, len(current))
+	for _, id := range current {
+		remaining[id] = struct{}{}
+	}
+	for _, id := range requested {
+		if _, ok := remaining[id]; !ok {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
+}
@@ -0,0 +1,252 @@
+package services
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"io"
+	"path/filepath"
+	"time"
+)
+
+const maxArchiveImages = 5000 // 한 번에 압축할 수 있는 최대 이미지 수
+
+var (
+	ErrInvalidArchiveRequest = errors.New("잘못된 압축 파일 요청입니다")
+	ErrNoArchiveImages       = errors.New("압축할 이미지가 없습니다")
+
+	errArchiveFileUnreadable = errors.New("원본 파일을 읽을 수 없습니다")
+)
+
+// ArchiveFilter 압축할 이미지 선택 조건, ImageIDs가 있으면 나머지 조건은 무시
+// AlbumID가 있으면 앨범 순서대로 압축하고 CategoryID, UserID는 무시
+type ArchiveFilter struct {
+	ImageIDs   []uint
+	AlbumID    uint
+	CategoryID uint
+	UserID     uint       // 관리자만 사용, 0이면 모든 사용자
+	From       *time.Time // 업로드 날짜가 From 이후인 이미지
+	To         *time.Time // 업로드 날짜가 To 이전인 이미지 (To 미포함)
+}
+
+// ArchiveOptions 압축 파일에 원본 이미지와 manifest.json 외에 추가로 담을 내용
+type ArchiveOptions struct {
+	IncludeThumbnails bool
+	ExtraFiles        []ArchiveFile
+}
+
+// ArchiveFile 압축 파일에 그대로 담을 파일
+type ArchiveFile struct {
+	Name string
+	Data []byte
+}
+
+type archiveService struct {
+	imageRepo      repositories.ImageRepository
+	categoryRepo   repositories.CategoryRepository
+	albumRepo      repositories.AlbumRepository
+	permissionRepo repositories.PermissionRepository
+	storage        storage.Storage
+}
+
+func NewArchiveService(imageRepo repositories.ImageRepository, categoryRepo repositories.CategoryRepository, albumRepo repositories.AlbumRepository, permissionRepo repositories.PermissionRepository, fileStorage storage.Storage) ArchiveService {
+	return &archiveService{imageRepo: imageRepo, categoryRepo: categoryRepo, albumRepo: albumRepo, permissionRepo: permissionRepo, storage: fileStorage}
+}
+
+// GetArchiveImages 압축할 이미지 조회
+// 응답을 보내기 시작하면 상태 코드를 바꿀 수 없으므로 압축하기 전에 권한과 개수를 검증
+func (s *archiveService) GetArchiveImages(filter ArchiveFilter, userID uint, isAdmin bool) ([]models.Image, error) {
+	if len(filter.ImageIDs) > 0 {
+		return s.getImagesByIDs(filter.ImageIDs, userID, isAdmin)
+	}
+
+	// 일반 사용자는 자신의 이미지만, 관리자는 지정한 사용자 또는 모든 사용자의 이미지를 압축
+	ownerID := userID
+	if isAdmin {
+		ownerID = filter.UserID
+	}
+
+	var images []models.Image
+	if filter.AlbumID != 0 {
+		albumImages, err := s.getAlbumImages(filter.AlbumID, userID, isAdmin)
+		if err != nil {
+			return nil, err
+		}
+		for _, image := range albumImages {
+			if filter.From != nil && image.UploadDate.Before(*filter.From) {
+				continue
+			}
+			if filter.To != nil && !image.UploadDate.Before(*filter.To) {
+				continue
+			}
+			images = append(images, image)
+		}
+	} else {
+		// 날짜 조건과 개수 제한을 쿼리에 넣고, 제한을 넘는지 알 수 있도록 한 개 더 조회
+		var err error
+		images, err = s.imageRepo.GetArchiveImages(ownerID, filter.CategoryID, filter.From, filter.To, maxArchiveImages+1)
+		if err != nil {
+			return nil, fmt.Errorf("압축할 이미지를 가져오는데 실패했습니다: %v", err)
+		}
+	}
+
+	if len(images) == 0 {
+		return nil, ErrNoArchiveImages
+	}
+	if len(images) > maxArchiveImages {
+		return nil, fmt.Errorf("%w: 한 번에 최대 %d개의 이미지만 압축할 수 있습니다", ErrInvalidArchiveRequest, maxArchiveImages)
+	}
+	return images, nil
+}
+
+// getImagesByIDs 선택한 이미지를 한 번에 조회하고 권한을 한 번에 검증, 요청한 순서대로 반환
+func (s *archiveService) getImagesByIDs(ids []uint, userID uint, isAdmin bool) ([]models.Image, error) {
+	imageIDs := uniqueIDs(ids)
+	if len(imageIDs) > maxArchiveImages {
+		return nil, fmt.Errorf("%w: 한 번에 최대 %d개의 이미지만 압축할 수 있습니다", ErrInvalidArchiveRequest, maxArchiveImages)
+	}
+
+	found, err := s.imageRepo.GetImagesByIDs(imageIDs)
+	if err != nil {
+		return nil, fmt.Errorf("압축할 이미지를 가져오는데 실패했습니다: %v", err)
+	}
+	imagesByID := make(map[uint]models.Image, len(found))
+	for _, image := range found {
+		imagesByID[image.ID] = image
+	}
+
+	images := make([]models.Image, 0, len(imageIDs))
+	for _, imageID := range imageIDs {
+		image, ok := imagesByID[imageID]
+		if !ok {
+			return nil, fmt.Errorf("%w: 이미지 %d", utils.ErrImageNotFound, imageID)
+		}
+		images = append(images, image)
+	}
+	if !isAdmin {
+		if err := utils.ValidateImagesPermission(s.permissionRepo, images, userID, utils.PermissionView); err != nil {
+			return nil, err
+		}
+	}
+	return images, nil
+}
+
+// getAlbumImages 앨범 이미지를 앨범 순서대로 조회, 일반 사용자는 자신의 앨범만 압축 가능
+func (s *archiveService) getAlbumImages(albumID, userID uint, isAdmin bool) ([]models.Image, error) {
+	if isAdmin {
+		if _, err := s.albumRepo.GetAlbumByID(albumID); err != nil {
+			return nil, fmt.Errorf("앨범을 찾을 수 없습니다: %v", err)
+		}
+	} else if _, err := utils.ValidateAlbumOwnership(s.albumRepo, albumID, userID); err != nil {
+		return nil, err
+	}
+
+	images, err := s.albumRepo.GetAlbumImages(albumID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("앨범 이미지를 가져오는데 실패했습니다: %v", err)
+	}
+	return images, nil
+}
+
+// WriteArchive 원본 이미지와 manifest.json을 ZIP으로 압축해서 w에 바로 쓰기
+// 이미지를 하나씩 저장소에서 읽어서 쓰므로 압축 파일 전체를 메모리에 올리지 않음
+func (s *archiveService) WriteArchive(w io.Writer, images []models.Image, options ArchiveOptions) error {
+	imageIDs := make([]uint, len(images))
+	for i, image := range images {
+		imageIDs[i] = image.ID
+	}
+	categoryNames, err := s.categoryRepo.GetCategoryNamesByImageIDs(imageIDs)
+	if err != nil {
+		return fmt.Errorf("이미지 카테고리를 가져오는데 실패했습니다: %v", err)
+	}
+	categoriesByImage := make(map[uint][]string, len(images))
+	for _, categoryName := range categoryNames {
+		categoriesByImage[categoryName.ImageID] = append(categoriesByImage[categoryName.ImageID], categoryName.Name)
+	}
+
+	zipWriter := zip.NewWriter(w)
+	for _, extraFile := range options.ExtraFiles {
+		fileWriter, err := zipWriter.Create(extraFile.Name)
+		if err != nil {
+			return fmt.Errorf("%s 파일을 만드는데 실패했습니다: %v", extraFile.Name, err)
+		}
+		if _, err := fileWriter.Write(extraFile.Data); err != nil {
+			return fmt.Errorf("%s 파일을 쓰는데 실패했습니다: %v", extraFile.Name, err)
+		}
+	}
+
+	manifest := models.ArchiveManifest{CreatedAt: time.Now(), ImageCount: len(images)}
+	for _, image := range images {
+		entry := models.ArchiveManifestImage{
+			ID:          image.ID,
+			FileName:    image.FileName,
+			Description: image.Description,
+			UserID:      image.UserID,
+			UploadDate:  image.UploadDate,
+			Categories:  categoriesByImage[image.ID],
+			ContentHash: image.ContentHash,
+		}
+		if entry.Categories == nil {
+			entry.Categories = []string{}
+		}
+
+		// 파일명이 같은 이미지가 있을 수 있으므로 이미지 ID를 붙여서 저장
+		archivePath := fmt.Sprintf("images/%d_%s", image.ID, image.FileName)
+		// 원본 파일을 읽지 못한 이미지는 건너뛰고 manifest.json에 사유를 남김
+		if err := s.addArchiveFile(zipWriter, archivePath, image.FilePath, image.UploadDate); errors.Is(err, errArchiveFileUnreadable) {
+			entry.Error = err.Error()
+		} else if err != nil {
+			return err
+		} else {
+			entry.Path = archivePath
+		}
+
+		if options.IncludeThumbnails && entry.Path != "" {
+			thumbnailPath := fmt.Sprintf("thumbnails/%d_%s", image.ID, filepath.Base(image.ThumbnailPath))
+			if err := s.addArchiveFile(zipWriter, thumbnailPath, image.ThumbnailPath, image.UploadDate); errors.Is(err, errArchiveFileUnreadable) {
+				entry.Error = "썸네일 파일을 읽을 수 없습니다"
+			} else if err != nil {
+				return err
+			} else {
+				entry.ThumbnailPath = thumbnailPath
+			}
+		}
+		manifest.Images = append(manifest.Images, entry)
+	}
+
+	manifestWriter, err := zipWriter.Create("manifest.json")
+	if err != nil {
+		return fmt.Errorf("manifest.json을 만드는데 실패했습니다: %v", err)
+	}
+	encoder := json.NewEncoder(manifestWriter)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(manifest); err != nil {
+		return fmt.Errorf("manifest.json을 쓰는데 실패했습니다: %v", err)
+	}
+	return zipWriter.Close()
+}
+
+// addArchiveFile 저장소의 파일을 압축 파일에 추가, 파일을 열지 못하면 errArchiveFileUnreadable 반환
+func (s *archiveService) addArchiveFile(zipWriter *zip.Writer, archivePath, storagePath string, modified time.Time) error {
+	file, err := s.storage.Open(storagePath)
+	if err != nil {
+		return errArchiveFileUnreadable
+	}
+	defer file.Close()
+
+	// 이미지는 이미 압축된 형식이므로 다시 압축하지 않고 저장
+	header := &zip.FileHeader{Name: archivePath, Method: zip.Store, Modified: modified}
+	fileWriter, err := zipWriter.CreateHeader(header)
+	if err != nil {
+		return fmt.Errorf("압축 파일에 이미지를 추가하는데 실패했습니다: %v", err)
+	}
+	if _, err := io.Copy(fileWriter, file); err != nil {
+		return fmt.Errorf("압축 파일에 이미지를 쓰는데 실패했습니다: %v", err)
+	}
+	return nil
+}
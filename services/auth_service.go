@@ -10,10 +10,11 @@ import (
 
 type authService struct {
 	userRepository repositories.UserRepository
+	jwt            *utils.JWT
 }
 
-func NewAuthService(userRepo repositories.UserRepository) AuthService {
-	return &authService{userRepository: userRepo}
+func NewAuthService(userRepo repositories.UserRepository, jwt *utils.JWT) AuthService {
+	return &authService{userRepository: userRepo, jwt: jwt}
 }
 
 func (s *authService) SignUp(user *models.User) error {
@@ -45,7 +46,7 @@ func (s *authService) Login(email, password string) (string, error) {
 	}
 
 	// JWT 토큰 생성
-	token, err := utils.GenerateToken(user.ID, user.Role)
+	token, err := s.jwt.GenerateToken(user.ID, user.Role)
 	if err != nil {
 		return "", err
 	}
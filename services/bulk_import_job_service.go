@@ -0,0 +1,316 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	bulkImportRetention    = 7 * 24 * time.Hour // 완료된 일괄 가져오기 결과 파일 보관 기간
+	bulkImportPollInterval = time.Minute        // 알림을 놓친 대기 중인 작업을 다시 확인하는 주기
+	bulkImportDir          = ".imports"         // 업로드 디렉토리 안에서 가져올 ZIP과 결과 파일을 저장하는 디렉토리
+)
+
+var (
+	ErrBulkImportJobNotFound    = errors.New("일괄 가져오기 작업을 찾을 수 없습니다")
+	ErrInvalidBulkImportArchive = errors.New("archive는 ZIP 파일이어야 합니다")
+)
+
+type bulkImportJobService struct {
+	bulkImportRepo    repositories.BulkImportRepository
+	bulkImportService BulkImportService
+	storage           storage.Storage
+	signer            *signedurl.Signer
+	uploadsDir        string
+	wake              chan struct{} // 새 작업이 들어오면 작업자를 깨움
+}
+
+func NewBulkImportJobService(bulkImportRepo repositories.BulkImportRepository, bulkImportService BulkImportService, fileStorage storage.Storage, signer *signedurl.Signer, storageConfig config.StorageConfig) BulkImportJobService {
+	return &bulkImportJobService{
+		bulkImportRepo:    bulkImportRepo,
+		bulkImportService: bulkImportService,
+		storage:           fileStorage,
+		signer:            signer,
+		uploadsDir:        storageConfig.UploadsDir,
+		wake:              make(chan struct{}, 1),
+	}
+}
+
+// RequestImport ZIP과 메타데이터 파일을 검증해서 저장소에 보관하고 작업을 대기열에 추가, 작업은 Run에서 백그라운드로 처리
+// metadata가 nil이면 ZIP 최상위의 metadata.csv, metadata.json을 사용
+func (s *bulkImportJobService) RequestImport(userID uint, archive io.ReaderAt, archiveSize int64, metadata io.Reader, metadataName string) (*models.BulkImportJob, error) {
+	zipReader, err := zip.NewReader(archive, archiveSize)
+	if err != nil {
+		return nil, ErrInvalidBulkImportArchive
+	}
+
+	var items []BulkImportItem
+	var metadataData []byte
+	if metadata != nil {
+		if metadataData, err = io.ReadAll(metadata); err != nil {
+			return nil, fmt.Errorf("메타데이터 파일을 읽는데 실패했습니다: %v", err)
+		}
+		items, err = s.bulkImportService.ParseSidecar(bytes.NewReader(metadataData), metadataName)
+	} else {
+		items, err = s.bulkImportService.LoadSidecar(zipReader)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 작업 ID를 알기 전에 파일을 저장하므로 디렉토리 이름은 임의 값으로 정함
+	dirName := make([]byte, 8)
+	if _, err := rand.Read(dirName); err != nil {
+		return nil, fmt.Errorf("작업 디렉토리 이름을 만드는데 실패했습니다: %v", err)
+	}
+	dir := filepath.Join(s.uploadsDir, bulkImportDir, hex.EncodeToString(dirName))
+
+	job := models.BulkImportJob{UserID: userID, Status: models.BulkImportJobStatusPending, ItemCount: len(items)}
+	job.ArchivePath = filepath.Join(dir, "archive.zip")
+	if _, err := s.storage.Save(job.ArchivePath, io.NewSectionReader(archive, 0, archiveSize)); err != nil {
+		s.removeJobFiles(&job)
+		return nil, fmt.Errorf("ZIP 파일을 저장하는데 실패했습니다: %v", err)
+	}
+	if metadata != nil {
+		job.MetadataPath = filepath.Join(dir, "metadata"+strings.ToLower(path.Ext(metadataName)))
+		if _, err := s.storage.Save(job.MetadataPath, bytes.NewReader(metadataData)); err != nil {
+			s.removeJobFiles(&job)
+			return nil, fmt.Errorf("메타데이터 파일을 저장하는데 실패했습니다: %v", err)
+		}
+	}
+	if err := s.bulkImportRepo.CreateBulkImportJob(&job); err != nil {
+		s.removeJobFiles(&job)
+		return nil, fmt.Errorf("일괄 가져오기 작업을 만드는데 실패했습니다: %v", err)
+	}
+
+	select {
+	case s.wake <- struct{}{}:
+	default: // 이미 깨울 예정이면 넘어감
+	}
+	return &job, nil
+}
+
+// GetImportJob 일괄 가져오기 작업 조회, 완료된 작업은 서명된 결과 파일 URL 포함
+func (s *bulkImportJobService) GetImportJob(jobID uint) (*models.BulkImportJob, error) {
+	job, err := s.bulkImportRepo.GetBulkImportJobByID(jobID)
+	if err != nil {
+		return nil, ErrBulkImportJobNotFound
+	}
+	if job.Status == models.BulkImportJobStatusCompleted && job.ExpiresAt != nil && time.Now().Before(*job.ExpiresAt) {
+		job.ReportURL = s.signer.Sign(job.ReportPath)
+	}
+	return job, nil
+}
+
+// Run ctx가 끝날 때까지 대기 중인 일괄 가져오기 작업을 하나씩 처리
+// 서버가 작업 도중 종료됐던 작업은 다시 대기 상태로 돌려서 처음부터 처리, 이미 가져온 파일은 원본 해시로 찾아서 건너뜀
+func (s *bulkImportJobService) Run(ctx context.Context) {
+	interrupted, err := s.bulkImportRepo.GetBulkImportJobsByStatus(models.BulkImportJobStatusRunning)
+	if err != nil {
+		log.Printf("중단된 일괄 가져오기 작업을 가져오는데 실패했습니다: %v", err)
+	}
+	for i := range interrupted {
+		interrupted[i].Status = models.BulkImportJobStatusPending
+		if err := s.bulkImportRepo.UpdateBulkImportJob(&interrupted[i]); err != nil {
+			log.Printf("일괄 가져오기 작업 %d를 다시 대기 상태로 바꾸는데 실패했습니다: %v", interrupted[i].ID, err)
+		}
+	}
+
+	ticker := time.NewTicker(bulkImportPollInterval)
+	defer ticker.Stop()
+	for {
+		s.processPendingImports(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpiredImports 보관 기간이 지난 결과 파일 삭제
+func (s *bulkImportJobService) PurgeExpiredImports() (int, error) {
+	jobs, err := s.bulkImportRepo.GetExpiredBulkImportJobs(time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("만료된 일괄 가져오기 작업을 가져오는데 실패했습니다: %v", err)
+	}
+
+	purged := 0
+	for i := range jobs {
+		job := &jobs[i]
+		if err := s.storage.Remove(job.ReportPath); err != nil {
+			return purged, fmt.Errorf("일괄 가져오기 결과 파일을 삭제하는데 실패했습니다: %v", err)
+		}
+		job.Status = models.BulkImportJobStatusExpired
+		job.ReportPath = ""
+		if err := s.bulkImportRepo.UpdateBulkImportJob(job); err != nil {
+			return purged, fmt.Errorf("일괄 가져오기 작업을 갱신하는데 실패했습니다: %v", err)
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+func (s *bulkImportJobService) processPendingImports(ctx context.Context) {
+	jobs, err := s.bulkImportRepo.GetBulkImportJobsByStatus(models.BulkImportJobStatusPending)
+	if err != nil {
+		log.Printf("대기 중인 일괄 가져오기 작업을 가져오는데 실패했습니다: %v", err)
+		return
+	}
+	for i := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		s.processImport(ctx, &jobs[i])
+	}
+}
+
+// processImport 일괄 가져오기 작업 하나를 처리하고 결과 파일과 개수를 저장
+// ctx가 끝나서 모든 항목을 처리하지 못하면 진행 중 상태로 두고, 다음 실행에서 처음부터 다시 처리
+func (s *bulkImportJobService) processImport(ctx context.Context, job *models.BulkImportJob) {
+	job.Status = models.BulkImportJobStatusRunning
+	if err := s.bulkImportRepo.UpdateBulkImportJob(job); err != nil {
+		log.Printf("일괄 가져오기 작업 %d를 시작하는데 실패했습니다: %v", job.ID, err)
+		return
+	}
+
+	report, err := s.importArchive(ctx, job)
+	if ctx.Err() != nil {
+		return
+	}
+
+	now := time.Now()
+	job.CompletedAt = &now
+	if err != nil {
+		job.Status = models.BulkImportJobStatusFailed
+		job.Error = err.Error()
+	} else {
+		job.ReportPath = filepath.Join(filepath.Dir(job.ArchivePath), "report.csv")
+		if _, err := s.storage.Save(job.ReportPath, report); err != nil {
+			log.Printf("일괄 가져오기 작업 %d의 결과 파일을 저장하는데 실패했습니다: %v", job.ID, err)
+			return
+		}
+		expiresAt := now.Add(bulkImportRetention)
+		job.Status = models.BulkImportJobStatusCompleted
+		job.ExpiresAt = &expiresAt
+	}
+
+	// 끝난 작업의 ZIP과 메타데이터 파일은 더 이상 필요 없으므로 삭제
+	s.removeJobFiles(job)
+	job.ArchivePath = ""
+	job.MetadataPath = ""
+	if err := s.bulkImportRepo.UpdateBulkImportJob(job); err != nil {
+		log.Printf("일괄 가져오기 작업 %d의 결과를 저장하는데 실패했습니다: %v", job.ID, err)
+	}
+}
+
+// importArchive 보관한 ZIP의 이미지를 가져오고 항목별 결과를 CSV로 기록해서 반환, 작업의 개수도 함께 갱신
+func (s *bulkImportJobService) importArchive(ctx context.Context, job *models.BulkImportJob) (*bytes.Buffer, error) {
+	zipReader, closeArchive, err := s.openArchive(job.ArchivePath)
+	if err != nil {
+		return nil, fmt.Errorf("ZIP 파일을 여는데 실패했습니다: %v", err)
+	}
+	defer closeArchive()
+
+	var items []BulkImportItem
+	if job.MetadataPath != "" {
+		metadataFile, err := s.storage.Open(job.MetadataPath)
+		if err != nil {
+			return nil, fmt.Errorf("메타데이터 파일을 여는데 실패했습니다: %v", err)
+		}
+		items, err = s.bulkImportService.ParseSidecar(metadataFile, job.MetadataPath)
+		metadataFile.Close()
+		if err != nil {
+			return nil, err
+		}
+	} else if items, err = s.bulkImportService.LoadSidecar(zipReader); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	report := NewBulkImportReport(&buf)
+	job.ItemCount = len(items)
+	job.Imported, job.Skipped, job.Failed = 0, 0, 0
+	s.bulkImportService.ImportImages(ctx, zipReader, items, DefaultBulkImportWorkers, func(result BulkImportResult) {
+		report.Write(result)
+		switch result.Status {
+		case BulkImportStatusImported:
+			job.Imported++
+		case BulkImportStatusSkipped:
+			job.Skipped++
+		default:
+			job.Failed++
+		}
+	})
+	return &buf, nil
+}
+
+// openArchive 저장소의 ZIP 파일 열기, 저장소 파일이 임의 위치 읽기를 지원하지 않으면 임시 파일에 복사해서 열기
+func (s *bulkImportJobService) openArchive(archivePath string) (*zip.Reader, func(), error) {
+	size, err := s.storage.Size(archivePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err := s.storage.Open(archivePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if readerAt, ok := file.(io.ReaderAt); ok {
+		zipReader, err := zip.NewReader(readerAt, size)
+		if err != nil {
+			file.Close()
+			return nil, nil, err
+		}
+		return zipReader, func() { file.Close() }, nil
+	}
+
+	defer file.Close()
+	tempFile, err := os.CreateTemp("", "bulk-import-*.zip")
+	if err != nil {
+		return nil, nil, err
+	}
+	closeTemp := func() {
+		tempFile.Close()
+		os.Remove(tempFile.Name())
+	}
+	if _, err := io.Copy(tempFile, file); err != nil {
+		closeTemp()
+		return nil, nil, err
+	}
+	zipReader, err := zip.NewReader(tempFile, size)
+	if err != nil {
+		closeTemp()
+		return nil, nil, err
+	}
+	return zipReader, closeTemp, nil
+}
+
+// removeJobFiles 작업의 ZIP과 메타데이터 파일 삭제
+func (s *bulkImportJobService) removeJobFiles(job *models.BulkImportJob) {
+	for _, filePath := range []string{job.ArchivePath, job.MetadataPath} {
+		if filePath == "" {
+			continue
+		}
+		if err := s.storage.Remove(filePath); err != nil {
+			log.Printf("일괄 가져오기 파일 %s를 삭제하는데 실패했습니다: %v", filePath, err)
+		}
+	}
+}
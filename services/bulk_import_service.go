@@ -0,0 +1,423 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	BulkImportStatusImported = "imported"
+	BulkImportStatusSkipped  = "skipped" // 같은 소유자에게 같은 원본 해시의 이미지가 이미 있어서 건너뜀
+	BulkImportStatusFailed   = "failed"
+
+	DefaultBulkImportWorkers = 4
+)
+
+var (
+	ErrInvalidBulkImportSidecar = errors.New("잘못된 일괄 가져오기 메타데이터 파일입니다")
+	ErrBulkImportSidecarMissing = errors.New("일괄 가져오기 메타데이터 파일을 찾을 수 없습니다")
+)
+
+// bulkImportSidecarNames 가져올 폴더나 ZIP 최상위에서 찾는 메타데이터 파일명
+var bulkImportSidecarNames = []string{"metadata.csv", "metadata.json"}
+
+// BulkImportItem 메타데이터 파일의 항목 하나
+type BulkImportItem struct {
+	Line        int        `json:"-"` // CSV 행 번호 또는 JSON 배열에서의 순서 (1부터)
+	File        string     `json:"file"`
+	OwnerEmail  string     `json:"owner_email"`
+	Description string     `json:"description"`
+	Categories  []string   `json:"categories"`
+	CapturedAt  *time.Time `json:"-"`
+}
+
+// BulkImportResult 일괄 가져오기에서 항목별 처리 결과
+type BulkImportResult struct {
+	Line              int      `json:"line"`
+	File              string   `json:"file"`
+	OwnerEmail        string   `json:"owner_email"`
+	Status            string   `json:"status"`
+	ImageID           uint     `json:"image_id,omitempty"`
+	InvalidCategories []string `json:"invalid_categories,omitempty"` // 존재하지 않아서 제외된 카테고리
+	Error             string   `json:"error,omitempty"`
+}
+
+// BulkImportReport 항목별 결과를 CSV로 기록, cmd/import와 관리자 일괄 가져오기 작업의 결과 파일 형식
+type BulkImportReport struct {
+	writer *csv.Writer
+}
+
+// NewBulkImportReport 헤더를 쓴 결과 파일 생성
+func NewBulkImportReport(w io.Writer) *BulkImportReport {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"line", "file", "owner_email", "status", "image_id", "invalid_categories", "error"})
+	return &BulkImportReport{writer: writer}
+}
+
+// Write 항목 하나의 결과를 쓰고 바로 내보내서, 중단돼도 이미 끝난 항목은 결과 파일에 남도록 함
+func (r *BulkImportReport) Write(result BulkImportResult) error {
+	var imageID string
+	if result.ImageID != 0 {
+		imageID = strconv.FormatUint(uint64(result.ImageID), 10)
+	}
+	r.writer.Write([]string{
+		strconv.Itoa(result.Line), result.File, result.OwnerEmail, result.Status, imageID,
+		strings.Join(result.InvalidCategories, ";"), result.Error,
+	})
+	r.writer.Flush()
+	return r.writer.Error()
+}
+
+type bulkImportService struct {
+	userRepo     repositories.UserRepository
+	imageRepo    repositories.ImageRepository
+	imageService ImageService
+}
+
+func NewBulkImportService(userRepo repositories.UserRepository, imageRepo repositories.ImageRepository, imageService ImageService) BulkImportService {
+	return &bulkImportService{userRepo: userRepo, imageRepo: imageRepo, imageService: imageService}
+}
+
+// LoadSidecar fsys 최상위의 metadata.csv 또는 metadata.json을 찾아서 파싱
+func (s *bulkImportService) LoadSidecar(fsys fs.FS) ([]BulkImportItem, error) {
+	for _, name := range bulkImportSidecarNames {
+		file, err := fsys.Open(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s 파일을 여는데 실패했습니다: %v", name, err)
+		}
+		defer file.Close()
+		return s.ParseSidecar(file, name)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrBulkImportSidecarMissing, strings.Join(bulkImportSidecarNames, ", "))
+}
+
+// ParseSidecar 파일명의 확장자(.csv, .json)에 따라 메타데이터 파일 파싱
+func (s *bulkImportService) ParseSidecar(r io.Reader, name string) ([]BulkImportItem, error) {
+	var items []BulkImportItem
+	var err error
+	switch strings.ToLower(path.Ext(name)) {
+	case ".csv":
+		items, err = parseBulkImportCSV(r)
+	case ".json":
+		items, err = parseBulkImportJSON(r)
+	default:
+		return nil, fmt.Errorf("%w: CSV 또는 JSON 파일만 가능합니다", ErrInvalidBulkImportSidecar)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: 가져올 항목이 없습니다", ErrInvalidBulkImportSidecar)
+	}
+	return items, nil
+}
+
+// ImportImages 메타데이터 항목의 파일을 fsys에서 읽어서 workers개씩 동시에 업로드
+// 이미 가져온 파일은 원본 해시로 찾아서 건너뛰므로, 중간에 실패하거나 중단돼도 같은 입력으로 다시 실행하면 이어서 가져옴
+// onResult가 있으면 항목이 끝날 때마다 호출하고, ctx가 끝나면 남은 항목은 시작하지 않음
+func (s *bulkImportService) ImportImages(ctx context.Context, fsys fs.FS, items []BulkImportItem, workers int, onResult func(BulkImportResult)) []BulkImportResult {
+	if workers <= 0 {
+		workers = DefaultBulkImportWorkers
+	}
+
+	results := make([]BulkImportResult, len(items))
+	dispatched := len(items) // ctx가 끝나서 시작하지 않은 항목은 결과에서 제외
+	var resultMu sync.Mutex
+	finish := func(i int, result BulkImportResult) {
+		results[i] = result
+		if onResult != nil {
+			resultMu.Lock()
+			onResult(result)
+			resultMu.Unlock()
+		}
+	}
+
+	type importJob struct {
+		index  int
+		userID uint
+	}
+	jobs := make(chan importJob)
+	claims := &contentClaims{claims: make(map[string]chan struct{})}
+	var wg sync.WaitGroup
+	for w := 0; w < min(workers, len(items)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				finish(job.index, s.importItem(fsys, items[job.index], job.userID, claims))
+			}
+		}()
+	}
+
+	// 소유자 조회는 순서대로 처리
+	ownerIDs := make(map[string]uint)
+	for i, item := range items {
+		if ctx.Err() != nil {
+			dispatched = i
+			break
+		}
+
+		result := BulkImportResult{Line: item.Line, File: item.File, OwnerEmail: item.OwnerEmail, Status: BulkImportStatusFailed}
+		if !fs.ValidPath(item.File) || item.File == "." {
+			result.Error = "잘못된 파일 경로입니다"
+			finish(i, result)
+			continue
+		}
+
+		userID, ok := ownerIDs[item.OwnerEmail]
+		if !ok {
+			user, err := s.userRepo.GetUserByEmail(item.OwnerEmail)
+			if err != nil {
+				result.Error = fmt.Sprintf("소유자 %s를 찾을 수 없습니다", item.OwnerEmail)
+				finish(i, result)
+				continue
+			}
+			userID = user.ID
+			ownerIDs[item.OwnerEmail] = userID
+		}
+
+		select {
+		case jobs <- importJob{index: i, userID: userID}:
+			continue
+		case <-ctx.Done():
+			dispatched = i
+		}
+		break
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results[:dispatched]
+}
+
+// contentClaims 한 번의 가져오기에서 같은 소유자의 같은 내용 파일을 동시에 가져오지 않도록 처리 중인 원본 해시를 기록
+type contentClaims struct {
+	mu     sync.Mutex
+	claims map[string]chan struct{}
+}
+
+// claim key를 처리할 차례가 될 때까지 기다린 뒤 차지하고, 처리가 끝나면 호출할 해제 함수 반환
+func (c *contentClaims) claim(key string) func() {
+	for {
+		c.mu.Lock()
+		done, ok := c.claims[key]
+		if !ok {
+			done = make(chan struct{})
+			c.claims[key] = done
+			c.mu.Unlock()
+			return func() {
+				c.mu.Lock()
+				delete(c.claims, key)
+				c.mu.Unlock()
+				close(done)
+			}
+		}
+		c.mu.Unlock()
+		<-done
+	}
+}
+
+// importItem 항목 하나를 업로드 흐름으로 저장하고 촬영 날짜 기록
+func (s *bulkImportService) importItem(fsys fs.FS, item BulkImportItem, userID uint, claims *contentClaims) BulkImportResult {
+	result := BulkImportResult{Line: item.Line, File: item.File, OwnerEmail: item.OwnerEmail, Status: BulkImportStatusFailed}
+
+	contentHash, err := hashFSFile(fsys, item.File)
+	if err != nil {
+		result.Error = fmt.Sprintf("파일을 읽는데 실패했습니다: %v", err)
+		return result
+	}
+	// 같은 내용의 파일이 먼저 처리 중이면 끝날 때까지 기다렸다가 이미 가져온 이미지로 건너뜀
+	release := claims.claim(fmt.Sprintf("%d/%s", userID, contentHash))
+	defer release()
+
+	existing, err := s.imageRepo.FindImageByUserIDAndContentHash(userID, contentHash)
+	if err != nil {
+		result.Error = fmt.Sprintf("이미 가져온 이미지인지 확인하는데 실패했습니다: %v", err)
+		return result
+	}
+	if existing != nil {
+		// 이전 실행에서 촬영 날짜를 저장하지 못한 이미지는 촬영 날짜만 다시 저장
+		if item.CapturedAt != nil && existing.CapturedAt == nil {
+			if err := s.imageRepo.UpdateImageCapturedAt(existing.ID, *item.CapturedAt); err != nil {
+				result.Error = fmt.Sprintf("촬영 날짜를 저장하는데 실패했습니다: %v", err)
+				return result
+			}
+		}
+		result.Status = BulkImportStatusSkipped
+		result.ImageID = existing.ID
+		return result
+	}
+
+	file, err := fsys.Open(item.File)
+	if err != nil {
+		result.Error = fmt.Sprintf("파일을 여는데 실패했습니다: %v", err)
+		return result
+	}
+	defer file.Close()
+
+	uploadImage, unknownNames, err := s.imageService.UploadImageFromReader(file, path.Base(item.File), item.Description, userID, item.Categories, false)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.ImageID = uploadImage.ID
+	result.InvalidCategories = unknownNames
+
+	if item.CapturedAt != nil {
+		if err := s.imageRepo.UpdateImageCapturedAt(uploadImage.ID, *item.CapturedAt); err != nil {
+			result.Error = fmt.Sprintf("촬영 날짜를 저장하는데 실패했습니다: %v", err)
+			return result
+		}
+	}
+	result.Status = BulkImportStatusImported
+	return result
+}
+
+// parseBulkImportCSV 첫 행이 헤더인 CSV 파싱
+// 컬럼: file, owner_email (필수), description, categories, capture_date
+func parseBulkImportCSV(r io.Reader) ([]BulkImportItem, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: 헤더를 읽을 수 없습니다: %v", ErrInvalidBulkImportSidecar, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, name := range []string{"file", "owner_email"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: %s 컬럼이 필요합니다", ErrInvalidBulkImportSidecar, name)
+		}
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var items []BulkImportItem
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBulkImportSidecar, err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		item := BulkImportItem{
+			Line:        line,
+			File:        field(record, "file"),
+			OwnerEmail:  field(record, "owner_email"),
+			Description: field(record, "description"),
+			Categories:  splitBulkImportCategories(field(record, "categories")),
+		}
+		if item.CapturedAt, err = parseCaptureDate(field(record, "capture_date")); err != nil {
+			return nil, fmt.Errorf("%w: %d행: %v", ErrInvalidBulkImportSidecar, line, err)
+		}
+		if err := validateBulkImportItem(item); err != nil {
+			return nil, fmt.Errorf("%w: %d행: %v", ErrInvalidBulkImportSidecar, line, err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func parseBulkImportJSON(r io.Reader) ([]BulkImportItem, error) {
+	var entries []struct {
+		BulkImportItem
+		CaptureDate string `json:"capture_date"`
+	}
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBulkImportSidecar, err)
+	}
+
+	items := make([]BulkImportItem, len(entries))
+	for i, entry := range entries {
+		item := entry.BulkImportItem
+		item.Line = i + 1
+		item.File = strings.TrimSpace(item.File)
+		item.OwnerEmail = strings.TrimSpace(item.OwnerEmail)
+		capturedAt, err := parseCaptureDate(strings.TrimSpace(entry.CaptureDate))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %d번째 항목: %v", ErrInvalidBulkImportSidecar, item.Line, err)
+		}
+		item.CapturedAt = capturedAt
+		if err := validateBulkImportItem(item); err != nil {
+			return nil, fmt.Errorf("%w: %d번째 항목: %v", ErrInvalidBulkImportSidecar, item.Line, err)
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+func validateBulkImportItem(item BulkImportItem) error {
+	if item.File == "" {
+		return errors.New("file이 필요합니다")
+	}
+	if item.OwnerEmail == "" {
+		return errors.New("owner_email이 필요합니다")
+	}
+	return nil
+}
+
+// splitBulkImportCategories 세미콜론, 파이프, 쉼표로 구분한 카테고리명 분리
+func splitBulkImportCategories(value string) []string {
+	var categories []string
+	for _, name := range strings.FieldsFunc(value, func(r rune) bool { return r == ';' || r == '|' || r == ',' }) {
+		if name = strings.TrimSpace(name); name != "" {
+			categories = append(categories, name)
+		}
+	}
+	return categories
+}
+
+// parseCaptureDate RFC 3339 또는 YYYY-MM-DD 형식의 촬영 날짜 파싱, 빈 값이면 nil
+func parseCaptureDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if capturedAt, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return &capturedAt, nil
+		}
+	}
+	return nil, fmt.Errorf("capture_date %q는 YYYY-MM-DD 또는 RFC 3339 형식이어야 합니다", value)
+}
+
+// hashFSFile 파일의 SHA-256 해시 계산, 업로드 시 저장하는 ContentHash와 같은 형식
+func hashFSFile(fsys fs.FS, name string) (string, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
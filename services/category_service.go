@@ -1,26 +1,55 @@
 package services
 
 import (
+	"errors"
 	"fmt"
 	"github.com/zeze1004/image-hub-platform/models"
 	"github.com/zeze1004/image-hub-platform/repositories"
 	"github.com/zeze1004/image-hub-platform/utils"
+	"time"
 )
 
+// BulkCategoryAction 여러 이미지에 일괄 적용할 카테고리 작업
+type BulkCategoryAction string
+
+const (
+	BulkCategoryAdd    BulkCategoryAction = "add"    // 카테고리 추가
+	BulkCategoryRemove BulkCategoryAction = "remove" // 카테고리 제거
+	BulkCategorySet    BulkCategoryAction = "set"    // 기존 카테고리를 요청한 카테고리로 교체
+
+	maxBulkImages         = 100 // 일괄 작업 한 번에 처리할 수 있는 최대 이미지 수
+	maxCategoriesPerImage = 5   // 이미지 하나가 가질 수 있는 최대 카테고리 수
+	maxStatsDays          = 366 // 일별 업로드 통계를 조회할 수 있는 최대 기간
+)
+
+var (
+	ErrInvalidBulkRequest = errors.New("잘못된 일괄 카테고리 요청입니다")
+	ErrTooManyCategories  = fmt.Errorf("이미지에는 카테고리를 최대 %d개까지 등록할 수 있습니다", maxCategoriesPerImage)
+	ErrInvalidStatsPeriod = errors.New("잘못된 통계 조회 기간입니다")
+)
+
+// BulkCategoryResult 일괄 카테고리 작업의 이미지별 결과
+type BulkCategoryResult struct {
+	ImageID uint   `json:"image_id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
 type categoryService struct {
 	imageRepo         repositories.ImageRepository
 	categoryRepo      repositories.CategoryRepository
 	imageCategoryRepo repositories.ImageCategoryRepository
+	permissionRepo    repositories.PermissionRepository
 }
 
-func NewCategoryService(imageRepo repositories.ImageRepository, categoryRepo repositories.CategoryRepository, imageCategoryRepo repositories.ImageCategoryRepository) CategoryService {
-	return &categoryService{imageRepo: imageRepo, categoryRepo: categoryRepo, imageCategoryRepo: imageCategoryRepo}
+func NewCategoryService(imageRepo repositories.ImageRepository, categoryRepo repositories.CategoryRepository, imageCategoryRepo repositories.ImageCategoryRepository, permissionRepo repositories.PermissionRepository) CategoryService {
+	return &categoryService{imageRepo: imageRepo, categoryRepo: categoryRepo, imageCategoryRepo: imageCategoryRepo, permissionRepo: permissionRepo}
 }
 
 // GetCategoriesByImageIDAndUserID 특정 이미지의 카테고리 조회
 func (s *categoryService) GetCategoriesByImageIDAndUserID(imageID, userID uint, isAdmin bool) ([]models.Category, error) {
 	if !isAdmin {
-		if err := s.validateImageOwnership(imageID, userID); err != nil {
+		if err := utils.ValidateImagePermission(s.imageRepo, s.permissionRepo, imageID, userID, utils.PermissionView); err != nil {
 			return nil, err
 		}
 	}
@@ -31,7 +60,7 @@ func (s *categoryService) GetCategoriesByImageIDAndUserID(imageID, userID uint,
 // AddCategoryToImageByImageIDAndCategoryID 이미지에 카테고리 추가
 func (s *categoryService) AddCategoryToImageByImageIDAndCategoryID(imageID, categoryID, userID uint, isAdmin bool) error {
 	if !isAdmin {
-		if err := s.validateImageOwnership(imageID, userID); err != nil {
+		if err := utils.ValidateImagePermission(s.imageRepo, s.permissionRepo, imageID, userID, utils.PermissionEdit); err != nil {
 			return err
 		}
 	}
@@ -41,19 +70,16 @@ func (s *categoryService) AddCategoryToImageByImageIDAndCategoryID(imageID, cate
 		return err
 	}
 
-	// 추가할 카테고리가 이미 이미지에 있는지 검증
-	err = s.isDuplicateCategory(imageID, categoryID)
-	if err != nil {
-		return err
-	}
-
-	return s.imageCategoryRepo.AddCategoryToImage(imageID, categoryID)
+	// 이미 붙은 카테고리는 최대 개수와 상관없이 그대로 성공, 개수 확인과 추가는 한 트랜잭션에서 처리
+	return s.imageCategoryRepo.Transaction(func(txRepo repositories.ImageCategoryRepository) error {
+		return applyBulkCategoryAction(txRepo, BulkCategoryAdd, imageID, []uint{categoryID})
+	})
 }
 
 // RemoveCategoryFromImageByImageIDAndCategoryID 이미지에서 카테고리 제거
 func (s *categoryService) RemoveCategoryFromImageByImageIDAndCategoryID(imageID, categoryID, userID uint, isAdmin bool) error {
 	if !isAdmin {
-		if err := s.validateImageOwnership(imageID, userID); err != nil {
+		if err := utils.ValidateImagePermission(s.imageRepo, s.permissionRepo, imageID, userID, utils.PermissionEdit); err != nil {
 			return err
 		}
 	}
@@ -72,6 +98,168 @@ func (s *categoryService) RemoveCategoryFromImageByImageIDAndCategoryID(imageID,
 	return s.imageCategoryRepo.RemoveCategoryFromImage(imageID, categoryID)
 }
 
+// BulkUpdateImageCategories 여러 이미지에 카테고리를 일괄 추가/제거/교체
+// 권한이 없거나 존재하지 않거나 변경에 실패한 이미지는 결과에 실패로 기록하고,
+// 나머지 이미지의 변경 사항은 하나의 트랜잭션으로 반영
+func (s *categoryService) BulkUpdateImageCategories(action BulkCategoryAction, imageIDs, categoryIDs []uint, userID uint, isAdmin bool) ([]BulkCategoryResult, error) {
+	switch action {
+	case BulkCategoryAdd, BulkCategoryRemove, BulkCategorySet:
+	default:
+		return nil, fmt.Errorf("%w: 지원하지 않는 작업입니다(%s)", ErrInvalidBulkRequest, action)
+	}
+
+	imageIDs = uniqueIDs(imageIDs)
+	categoryIDs = uniqueIDs(categoryIDs)
+	if len(imageIDs) == 0 || len(imageIDs) > maxBulkImages {
+		return nil, fmt.Errorf("%w: 이미지는 1 ~ %d개까지 요청할 수 있습니다", ErrInvalidBulkRequest, maxBulkImages)
+	}
+	// set은 빈 목록으로 모든 카테고리를 지울 수 있지만, add/remove는 카테고리가 필요
+	if len(categoryIDs) == 0 && action != BulkCategorySet {
+		return nil, fmt.Errorf("%w: 카테고리가 비어 있습니다", ErrInvalidBulkRequest)
+	}
+	if len(categoryIDs) > maxCategoriesPerImage && action != BulkCategoryRemove {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBulkRequest, ErrTooManyCategories)
+	}
+	for _, categoryID := range categoryIDs {
+		if err := s.isValidCategoryID(categoryID); err != nil {
+			return nil, fmt.Errorf("%w: %v(%d)", ErrInvalidBulkRequest, err, categoryID)
+		}
+	}
+
+	// 이미지별 권한 검증, 실패한 이미지는 결과에만 기록
+	results := make([]BulkCategoryResult, len(imageIDs))
+	var allowed []int
+	for i, imageID := range imageIDs {
+		results[i].ImageID = imageID
+		if err := s.validateBulkTarget(imageID, userID, isAdmin); err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		allowed = append(allowed, i)
+	}
+
+	// 이미지마다 세이브포인트를 두고, 최대 개수를 넘거나 DB 에러로 실패한 이미지는 그 이미지의 변경만 되돌리고 결과에 기록
+	err := s.imageCategoryRepo.Transaction(func(txRepo repositories.ImageCategoryRepository) error {
+		for _, i := range allowed {
+			err := txRepo.Transaction(func(imageRepo repositories.ImageCategoryRepository) error {
+				return applyBulkCategoryAction(imageRepo, action, results[i].ImageID, categoryIDs)
+			})
+			if err != nil {
+				results[i].Error = err.Error()
+				continue
+			}
+			results[i].Success = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("카테고리 일괄 변경에 실패했습니다: %w", err)
+	}
+	return results, nil
+}
+
+// GetCategoryStats 카테고리별, 사용자별 이미지 수와 [from, to) 기간의 일별 업로드 수 조회
+// userID가 0이면 전체 사용자를 대상으로 집계
+func (s *categoryService) GetCategoryStats(userID uint, from, to time.Time) (*models.CategoryStats, error) {
+	if !from.Before(to) || to.Sub(from) > maxStatsDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: 시작일은 종료일보다 앞서야 하고 최대 %d일까지 조회할 수 있습니다", ErrInvalidStatsPeriod, maxStatsDays)
+	}
+
+	categoryCounts, err := s.categoryRepo.CountImagesByCategory(userID)
+	if err != nil {
+		return nil, fmt.Errorf("카테고리별 이미지 수를 집계하는데 실패했습니다: %v", err)
+	}
+	userCounts, err := s.categoryRepo.CountImagesByUserAndCategory(userID)
+	if err != nil {
+		return nil, fmt.Errorf("사용자별 이미지 수를 집계하는데 실패했습니다: %v", err)
+	}
+	dailyUploads, err := s.categoryRepo.CountDailyUploadsByCategory(from, to, userID)
+	if err != nil {
+		return nil, fmt.Errorf("일별 업로드 수를 집계하는데 실패했습니다: %v", err)
+	}
+
+	return &models.CategoryStats{
+		Categories:   categoryCounts,
+		Users:        userCounts,
+		DailyUploads: dailyUploads,
+	}, nil
+}
+
+// validateBulkTarget 일괄 작업 대상 이미지가 존재하고 수정 권한이 있는지 검증
+func (s *categoryService) validateBulkTarget(imageID, userID uint, isAdmin bool) error {
+	if isAdmin {
+		if _, err := s.imageRepo.GetImageByID(imageID); err != nil {
+			return fmt.Errorf("이미지를 찾을 수 없습니다: %v", err)
+		}
+		return nil
+	}
+	return utils.ValidateImagePermission(s.imageRepo, s.permissionRepo, imageID, userID, utils.PermissionEdit)
+}
+
+// applyBulkCategoryAction 트랜잭션 안에서 이미지 하나에 카테고리 작업 적용
+func applyBulkCategoryAction(repo repositories.ImageCategoryRepository, action BulkCategoryAction, imageID uint, categoryIDs []uint) error {
+	// 같은 이미지에 동시에 카테고리를 추가하는 요청이 최대 개수를 넘지 않도록 트랜잭션이 끝날 때까지 이미지를 잠금
+	if err := repo.LockImage(imageID); err != nil {
+		return err
+	}
+
+	switch action {
+	case BulkCategoryRemove:
+		for _, categoryID := range categoryIDs {
+			if err := repo.RemoveCategoryFromImage(imageID, categoryID); err != nil {
+				return err
+			}
+		}
+		return nil
+	case BulkCategorySet:
+		if err := repo.RemoveAllCategoriesFromImage(imageID); err != nil {
+			return err
+		}
+	}
+
+	// 이미 등록된 카테고리는 중복으로 추가하지 않음
+	current, err := repo.GetCategoriesByImageID(imageID)
+	if err != nil {
+		return err
+	}
+	attached := make(map[uint]struct{}, len(current))
+	for _, category := range current {
+		attached[category.ID] = struct{}{}
+	}
+	total := len(current)
+	for _, categoryID := range categoryIDs {
+		if _, ok := attached[categoryID]; !ok {
+			total++
+		}
+	}
+	if total > maxCategoriesPerImage {
+		return ErrTooManyCategories
+	}
+	for _, categoryID := range categoryIDs {
+		if _, ok := attached[categoryID]; ok {
+			continue
+		}
+		if err := repo.AddCategoryToImage(imageID, categoryID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uniqueIDs 순서를 유지하면서 중복 ID 제거
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]struct{}, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
 func (s *categoryService) isDuplicateCategory(imageID uint, categoryID uint) error {
 	// 카테고리 중복 검증
 	categories, err := s.imageCategoryRepo.GetCategoriesByImageID(imageID)
@@ -92,7 +280,3 @@ func (s *categoryService) isValidCategoryID(categoryID uint) error {
 	}
 	return nil
 }
-
-func (s *categoryService) validateImageOwnership(imageID, userID uint) error {
-	return utils.ValidateImageOwnership(s.imageRepo, imageID, userID)
-}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"io"
+	"log"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const (
+	exportRetention    = 7 * 24 * time.Hour // 완료된 내보내기 파일 보관 기간
+	exportPollInterval = time.Minute        // 알림을 놓친 대기 중인 작업을 다시 확인하는 주기
+	exportDir          = ".exports"         // 업로드 디렉토리 안에서 내보내기 파일을 저장하는 디렉토리
+)
+
+var (
+	ErrExportNotFound   = errors.New("내보내기 작업을 찾을 수 없습니다")
+	ErrExportInProgress = errors.New("이미 진행 중인 내보내기 작업이 있습니다")
+)
+
+// ExportNotifier 내보내기 작업이 끝났을 때 사용자에게 알림
+// 메일이나 푸시로 알리려면 이 인터페이스를 구현해서 NewExportService에 넘김
+type ExportNotifier interface {
+	NotifyExportFinished(user *models.User, job *models.ExportJob) error
+}
+
+type logExportNotifier struct{}
+
+// NewLogExportNotifier 사용자에게 알림을 보내지 않고 서버 로그에만 남기는 기본 알림
+// 아직 메일 발송 기능이 없으므로 사용자는 GET /api/user/export/:jobID로 작업 상태와 다운로드 URL을 확인
+func NewLogExportNotifier() ExportNotifier {
+	return logExportNotifier{}
+}
+
+func (logExportNotifier) NotifyExportFinished(user *models.User, job *models.ExportJob) error {
+	if job.Status == models.ExportStatusCompleted {
+		log.Printf("사용자 %s의 내보내기 작업 %d가 완료됐습니다 (이미지 %d개, %d bytes)", user.Email, job.ID, job.ImageCount, job.Size)
+	} else {
+		log.Printf("사용자 %s의 내보내기 작업 %d가 실패했습니다: %s", user.Email, job.ID, job.Error)
+	}
+	return nil
+}
+
+type exportService struct {
+	exportRepo     repositories.ExportRepository
+	userRepo       repositories.UserRepository
+	imageRepo      repositories.ImageRepository
+	archiveService ArchiveService
+	storage        storage.Storage
+	signer         *signedurl.Signer
+	notifier       ExportNotifier
+	uploadsDir     string
+	wake           chan struct{} // 새 작업이 들어오면 작업자를 깨움
+}
+
+func NewExportService(exportRepo repositories.ExportRepository, userRepo repositories.UserRepository, imageRepo repositories.ImageRepository, archiveService ArchiveService, fileStorage storage.Storage, signer *signedurl.Signer, notifier ExportNotifier, storageConfig config.StorageConfig) ExportService {
+	return &exportService{
+		exportRepo:     exportRepo,
+		userRepo:       userRepo,
+		imageRepo:      imageRepo,
+		archiveService: archiveService,
+		storage:        fileStorage,
+		signer:         signer,
+		notifier:       notifier,
+		uploadsDir:     storageConfig.UploadsDir,
+		wake:           make(chan struct{}, 1),
+	}
+}
+
+// RequestExport 내보내기 작업을 대기열에 추가, 작업은 Run에서 백그라운드로 처리
+func (s *exportService) RequestExport(userID uint, includeRenditions bool) (*models.ExportJob, error) {
+	job := models.ExportJob{UserID: userID, Status: models.ExportStatusPending, IncludeRenditions: includeRenditions}
+	active, err := s.exportRepo.CreateExportJobIfIdle(&job)
+	if err != nil {
+		return nil, fmt.Errorf("내보내기 작업을 만드는데 실패했습니다: %v", err)
+	}
+	if active != nil {
+		return nil, fmt.Errorf("%w: 작업 %d", ErrExportInProgress, active.ID)
+	}
+
+	select {
+	case s.wake <- struct{}{}:
+	default: // 이미 깨울 예정이면 넘어감
+	}
+	return &job, nil
+}
+
+// GetExportJob 내보내기 작업 조회, 완료된 작업은 서명된 다운로드 URL 포함
+func (s *exportService) GetExportJob(jobID, userID uint) (*models.ExportJob, error) {
+	job, err := s.exportRepo.GetExportJobByID(jobID)
+	if err != nil || job.UserID != userID {
+		return nil, ErrExportNotFound
+	}
+	s.signDownloadURL(job)
+	return job, nil
+}
+
+// GetExportJobs 사용자의 내보내기 작업 목록 조회
+func (s *exportService) GetExportJobs(userID uint) ([]models.ExportJob, error) {
+	jobs, err := s.exportRepo.GetExportJobsByUserID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("내보내기 작업을 가져오는데 실패했습니다: %v", err)
+	}
+	for i := range jobs {
+		s.signDownloadURL(&jobs[i])
+	}
+	return jobs, nil
+}
+
+// Run ctx가 끝날 때까지 대기 중인 내보내기 작업을 하나씩 처리
+// 서버가 작업 도중 종료됐던 작업은 다시 대기 상태로 돌려서 처음부터 처리
+func (s *exportService) Run(ctx context.Context) {
+	interrupted, err := s.exportRepo.GetExportJobsByStatus(models.ExportStatusRunning)
+	if err != nil {
+		log.Printf("중단된 내보내기 작업을 가져오는데 실패했습니다: %v", err)
+	}
+	for i := range interrupted {
+		interrupted[i].Status = models.ExportStatusPending
+		if err := s.exportRepo.UpdateExportJob(&interrupted[i]); err != nil {
+			log.Printf("내보내기 작업 %d를 다시 대기 상태로 바꾸는데 실패했습니다: %v", interrupted[i].ID, err)
+		}
+	}
+
+	ticker := time.NewTicker(exportPollInterval)
+	defer ticker.Stop()
+	for {
+		s.processPendingExports(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpiredExports 보관 기간이 지난 내보내기 파일 삭제
+func (s *exportService) PurgeExpiredExports() (int, error) {
+	jobs, err := s.exportRepo.GetExpiredExportJobs(time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("만료된 내보내기 작업을 가져오는데 실패했습니다: %v", err)
+	}
+
+	purged := 0
+	for i := range jobs {
+		job := &jobs[i]
+		if err := s.storage.Remove(job.FilePath); err != nil {
+			return purged, fmt.Errorf("내보내기 파일을 삭제하는데 실패했습니다: %v", err)
+		}
+		job.Status = models.ExportStatusExpired
+		job.FilePath = ""
+		if err := s.exportRepo.UpdateExportJob(job); err != nil {
+			return purged, fmt.Errorf("내보내기 작업을 갱신하는데 실패했습니다: %v", err)
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+func (s *exportService) processPendingExports(ctx context.Context) {
+	jobs, err := s.exportRepo.GetExportJobsByStatus(models.ExportStatusPending)
+	if err != nil {
+		log.Printf("대기 중인 내보내기 작업을 가져오는데 실패했습니다: %v", err)
+		return
+	}
+	for i := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		s.processExport(&jobs[i])
+	}
+}
+
+// processExport 내보내기 작업 하나를 처리하고 결과를 저장한 뒤 사용자에게 알림
+func (s *exportService) processExport(job *models.ExportJob) {
+	job.Status = models.ExportStatusRunning
+	if err := s.exportRepo.UpdateExportJob(job); err != nil {
+		log.Printf("내보내기 작업 %d를 시작하는데 실패했습니다: %v", job.ID, err)
+		return
+	}
+
+	user, err := s.userRepo.GetUserByID(job.UserID)
+	if err == nil {
+		err = s.writeExport(job, user)
+	}
+
+	now := time.Now()
+	job.CompletedAt = &now
+	if err != nil {
+		job.Status = models.ExportStatusFailed
+		job.Error = err.Error()
+	} else {
+		expiresAt := now.Add(exportRetention)
+		job.Status = models.ExportStatusCompleted
+		job.ExpiresAt = &expiresAt
+	}
+	if err := s.exportRepo.UpdateExportJob(job); err != nil {
+		log.Printf("내보내기 작업 %d의 결과를 저장하는데 실패했습니다: %v", job.ID, err)
+		return
+	}
+
+	if user != nil {
+		if err := s.notifier.NotifyExportFinished(user, job); err != nil {
+			log.Printf("내보내기 작업 %d의 완료 알림을 보내는데 실패했습니다: %v", job.ID, err)
+		}
+	}
+}
+
+// writeExport 프로필, 이미지, 메타데이터, 카테고리를 압축해서 저장소에 저장
+// 압축 결과를 파이프로 저장소에 바로 쓰므로 압축 파일 전체를 메모리에 올리지 않음
+func (s *exportService) writeExport(job *models.ExportJob, user *models.User) error {
+	images, err := s.imageRepo.GetImagesByUserID(user.ID)
+	if err != nil {
+		return fmt.Errorf("이미지를 가져오는데 실패했습니다: %v", err)
+	}
+
+	profile, err := json.MarshalIndent(models.ExportProfile{
+		ID:        user.ID,
+		Email:     user.Email,
+		Role:      user.Role,
+		CreatedAt: user.CreatedAt,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("profile.json을 만드는데 실패했습니다: %v", err)
+	}
+	options := ArchiveOptions{
+		IncludeThumbnails: job.IncludeRenditions,
+		ExtraFiles:        []ArchiveFile{{Name: "profile.json", Data: profile}},
+	}
+
+	filePath := s.exportFilePath(job)
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(s.archiveService.WriteArchive(pw, images, options))
+	}()
+	size, err := s.storage.Save(filePath, pr)
+	pr.Close()
+	if err != nil {
+		s.storage.Remove(filePath)
+		return fmt.Errorf("내보내기 파일을 만드는데 실패했습니다: %v", err)
+	}
+
+	job.FilePath = filePath
+	job.Size = size
+	job.ImageCount = len(images)
+	return nil
+}
+
+// signDownloadURL 보관 기간이 남은 완료된 작업에 서명된 다운로드 URL 설정
+func (s *exportService) signDownloadURL(job *models.ExportJob) {
+	if job.Status != models.ExportStatusCompleted || job.ExpiresAt == nil || time.Now().After(*job.ExpiresAt) {
+		return
+	}
+	job.DownloadURL = s.signer.Sign(job.FilePath)
+}
+
+func (s *exportService) exportFilePath(job *models.ExportJob) string {
+	return filepath.Join(s.uploadsDir, exportDir, strconv.FormatUint(uint64(job.UserID), 10), fmt.Sprintf("image-hub-export-%d.zip", job.ID))
+}
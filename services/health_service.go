@@ -0,0 +1,127 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const readinessTimeout = 3 * time.Second // 준비 상태 확인 한 번에 걸리는 최대 시간, 로드밸런서의 확인 주기보다 짧아야 함
+
+// 준비 상태 확인 항목
+const (
+	ReadinessCheckShutdown   = "shutdown"
+	ReadinessCheckDatabase   = "database"
+	ReadinessCheckStorage    = "storage"
+	ReadinessCheckMigrations = "migrations"
+)
+
+// ReadinessCheck 준비 상태 확인 항목 하나의 결과
+type ReadinessCheck struct {
+	Name  string `json:"name"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// Readiness 준비 상태 확인 결과, 모든 항목이 통과해야 Ready
+type Readiness struct {
+	Ready  bool             `json:"ready"`
+	Checks []ReadinessCheck `json:"checks"`
+}
+
+type healthService struct {
+	healthRepo repositories.HealthRepository
+	storage    storage.Storage
+	uploadsDir string
+	background *lifecycle.Registry
+}
+
+func NewHealthService(healthRepo repositories.HealthRepository, fileStorage storage.Storage, storageConfig config.StorageConfig, background *lifecycle.Registry) HealthService {
+	return &healthService{
+		healthRepo: healthRepo,
+		storage:    fileStorage,
+		uploadsDir: storageConfig.UploadsDir,
+		background: background,
+	}
+}
+
+// Readiness 요청을 받을 준비가 됐는지 확인
+// 종료 중이면 다른 항목은 확인하지 않고 실패, 아니면 DB 연결, 저장소 쓰기와 읽기, 스키마 마이그레이션 적용 여부를 확인
+func (s *healthService) Readiness(ctx context.Context) Readiness {
+	if s.background.Stopping() {
+		return newReadiness(ReadinessCheck{Name: ReadinessCheckShutdown, Error: "서버가 종료 중입니다"})
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+	return newReadiness(
+		newReadinessCheck(ReadinessCheckDatabase, s.healthRepo.Ping(ctx)),
+		newReadinessCheck(ReadinessCheckStorage, s.probeStorage()),
+		newReadinessCheck(ReadinessCheckMigrations, s.checkMigrations(ctx)),
+	)
+}
+
+// probeStorage 업로드 디렉토리에 파일을 쓰고 다시 읽어서 같은 내용인지 확인한 뒤 삭제
+func (s *healthService) probeStorage() error {
+	probePath := filepath.Join(s.uploadsDir, fmt.Sprintf(".readyz_%d", time.Now().UnixNano()))
+	payload := []byte(probePath)
+	defer s.storage.Remove(probePath)
+
+	if _, err := s.storage.Save(probePath, bytes.NewReader(payload)); err != nil {
+		return fmt.Errorf("저장소에 쓰는데 실패했습니다: %v", err)
+	}
+	file, err := s.storage.Open(probePath)
+	if err != nil {
+		return fmt.Errorf("저장소에서 읽는데 실패했습니다: %v", err)
+	}
+	defer file.Close()
+	read, err := io.ReadAll(file)
+	if err != nil {
+		return fmt.Errorf("저장소에서 읽는데 실패했습니다: %v", err)
+	}
+	if !bytes.Equal(read, payload) {
+		return errors.New("저장소에서 읽은 내용이 쓴 내용과 다릅니다")
+	}
+	return nil
+}
+
+// checkMigrations 적용하지 않은 스키마 마이그레이션이 있으면 에러
+func (s *healthService) checkMigrations(ctx context.Context) error {
+	pending, err := s.healthRepo.GetPendingMigrations(ctx)
+	if err != nil {
+		return fmt.Errorf("마이그레이션 상태를 확인하는데 실패했습니다: %v", err)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	names := make([]string, len(pending))
+	for i, migration := range pending {
+		names[i] = fmt.Sprintf("%04d_%s", migration.Version, migration.Name)
+	}
+	return fmt.Errorf("적용하지 않은 마이그레이션이 있습니다: %s", strings.Join(names, ", "))
+}
+
+func newReadinessCheck(name string, err error) ReadinessCheck {
+	check := ReadinessCheck{Name: name, OK: err == nil}
+	if err != nil {
+		check.Error = err.Error()
+	}
+	return check
+}
+
+func newReadiness(checks ...ReadinessCheck) Readiness {
+	readiness := Readiness{Ready: true, Checks: checks}
+	for _, check := range checks {
+		readiness.Ready = readiness.Ready && check.OK
+	}
+	return readiness
+}
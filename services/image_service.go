@@ -1,64 +1,229 @@
 package services
 
 import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/nfnt/resize"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
+	"github.com/zeze1004/image-hub-platform/metrics"
 	"github.com/zeze1004/image-hub-platform/models"
 	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/storage"
 	"github.com/zeze1004/image-hub-platform/utils"
 	"image"
 	"image/jpeg"
 	_ "image/png"
-	"os"
+	"io"
+	"log"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
 
+const (
+	maxBatchUploadFiles   = 200 // 한 번에 업로드할 수 있는 최대 파일 수
+	maxBatchUploadWorkers = 4   // 여러 파일 업로드 시 동시에 처리하는 최대 파일 수
+)
+
+var ErrInvalidBatchUpload = errors.New("잘못된 여러 파일 업로드 요청입니다")
+
+// BatchUploadFile 여러 파일 업로드에서 파일 하나의 정보
+type BatchUploadFile struct {
+	FileName      string
+	Description   string
+	CategoryNames []string
+	Open          func() (io.ReadCloser, error)
+}
+
+// BatchUploadResult 여러 파일 업로드에서 파일별 처리 결과
+type BatchUploadResult struct {
+	FileName          string        `json:"file_name"`
+	Success           bool          `json:"success"`
+	Image             *models.Image `json:"image,omitempty"`
+	InvalidCategories []string      `json:"invalid_categories,omitempty"` // lenient 모드에서 제외된 카테고리
+	Error             string        `json:"error,omitempty"`
+}
+
 type imageService struct {
 	imageRepo         repositories.ImageRepository
 	categoryRepo      repositories.CategoryRepository
 	imageCategoryRepo repositories.ImageCategoryRepository
+	permissionRepo    repositories.PermissionRepository
+	storage           storage.Storage
+	uploadsDir        string
+	imageConfig       config.ImageConfig
+	background        *lifecycle.Registry // 응답 후에도 이어지는 파일 삭제를 서버 종료 시 끝까지 기다리기 위해 사용
 }
 
-func NewImageService(imageRepo repositories.ImageRepository, categoryRepo repositories.CategoryRepository, imageCategoryRepo repositories.ImageCategoryRepository) ImageService {
-	return &imageService{imageRepo: imageRepo, categoryRepo: categoryRepo, imageCategoryRepo: imageCategoryRepo}
+func NewImageService(imageRepo repositories.ImageRepository, categoryRepo repositories.CategoryRepository, imageCategoryRepo repositories.ImageCategoryRepository, permissionRepo repositories.PermissionRepository, fileStorage storage.Storage, storageConfig config.StorageConfig, imageConfig config.ImageConfig, background *lifecycle.Registry) ImageService {
+	return &imageService{
+		imageRepo:         imageRepo,
+		categoryRepo:      categoryRepo,
+		imageCategoryRepo: imageCategoryRepo,
+		permissionRepo:    permissionRepo,
+		storage:           fileStorage,
+		uploadsDir:        storageConfig.UploadsDir,
+		imageConfig:       imageConfig,
+		background:        background,
+	}
+}
+
+// UnknownCategoriesError 업로드 요청에 존재하지 않는 카테고리명이 포함된 경우의 에러
+type UnknownCategoriesError struct {
+	Names []string
+}
+
+func (e *UnknownCategoriesError) Error() string {
+	return fmt.Sprintf("존재하지 않는 카테고리입니다: %s", strings.Join(e.Names, ", "))
 }
 
 // UploadImage - 이미지 파일을 저장하고 메타데이터를 DB에 저장
-func (s *imageService) UploadImage(ctx *gin.Context, fileName, description string, userID uint, categoryNames []string) (*models.Image, error) {
-	// 유저별 디렉토리 생성
-	saveDir := fmt.Sprintf("./uploads/%d", userID)
-	if _, err := os.Stat(saveDir); os.IsNotExist(err) {
-		if err := os.MkdirAll(saveDir, os.ModePerm); err != nil {
-			return nil, fmt.Errorf("서버에 이미지 디렉토리 만드는데 실패했습니다: %v", err)
-		}
+// strictCategories가 true면 존재하지 않는 카테고리명이 있을 때 UnknownCategoriesError를 반환하고,
+// false면 해당 카테고리를 건너뛰고 업로드한 뒤 건너뛴 카테고리명을 함께 반환
+func (s *imageService) UploadImage(ctx *gin.Context, fileName, description string, userID uint, categoryNames []string, strictCategories bool) (*models.Image, []string, error) {
+	// 카테고리 검색, 파일을 저장하기 전에 검증해서 실패한 요청의 파일이 남지 않도록 함
+	categories, unknownNames, err := s.validateCategories(categoryNames, strictCategories)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// 저장할 파일 경로 설정
-	filePath := filepath.Join(saveDir, fileName)
-
 	fileHeader, err := ctx.FormFile("image")
 	if err != nil {
-		return nil, fmt.Errorf("form file을 가져오는데 실패했습니다: %v", err)
+		return nil, nil, fmt.Errorf("form file을 가져오는데 실패했습니다: %v", err)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, nil, fmt.Errorf("form file을 여는데 실패했습니다: %v", err)
 	}
+	defer file.Close()
 
-	// 이미지 파일 저장
-	if err := ctx.SaveUploadedFile(fileHeader, filePath); err != nil {
-		return nil, fmt.Errorf("이미지를 저장하는데 실패했습니다: %v", err)
+	uploadImage, err := s.saveImage(file, fileName, description, userID, categories)
+	if err != nil {
+		return nil, nil, err
 	}
+	return uploadImage, unknownNames, nil
+}
 
-	// 썸네일 생성
-	thumbPath, err := s.createThumbnail(filePath, saveDir, fileName)
+// UploadImageFromReader - multipart 요청이 아닌 r에서 읽은 이미지를 UploadImage와 같은 흐름으로 저장
+func (s *imageService) UploadImageFromReader(r io.Reader, fileName, description string, userID uint, categoryNames []string, strictCategories bool) (*models.Image, []string, error) {
+	categories, unknownNames, err := s.validateCategories(categoryNames, strictCategories)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uploadImage, err := s.saveImage(r, fileName, description, userID, categories)
+	if err != nil {
+		return nil, nil, err
+	}
+	return uploadImage, unknownNames, nil
+}
+
+// UploadImages - 여러 이미지를 최대 maxBatchUploadWorkers개씩 동시에 업로드하고, 요청 순서대로 파일별 결과 반환
+// 일부 파일이 실패해도 나머지 파일은 업로드
+func (s *imageService) UploadImages(files []BatchUploadFile, userID uint, strictCategories bool) ([]BatchUploadResult, error) {
+	if len(files) == 0 || len(files) > maxBatchUploadFiles {
+		return nil, fmt.Errorf("%w: 파일은 1개 이상 %d개 이하만 가능합니다", ErrInvalidBatchUpload, maxBatchUploadFiles)
+	}
+
+	results := make([]BatchUploadResult, len(files))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(maxBatchUploadWorkers, len(files)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = s.uploadBatchFile(files[i], userID, strictCategories)
+			}
+		}()
+	}
+
+	for i := range files {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results, nil
+}
+
+// uploadBatchFile 여러 파일 업로드에서 파일 하나를 업로드
+func (s *imageService) uploadBatchFile(file BatchUploadFile, userID uint, strictCategories bool) BatchUploadResult {
+	result := BatchUploadResult{FileName: file.FileName}
+
+	reader, err := file.Open()
+	if err != nil {
+		result.Error = fmt.Sprintf("파일을 여는데 실패했습니다: %v", err)
+		return result
+	}
+	defer reader.Close()
+
+	uploadImage, unknownNames, err := s.UploadImageFromReader(reader, file.FileName, file.Description, userID, file.CategoryNames, strictCategories)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Success = true
+	result.Image = uploadImage
+	result.InvalidCategories = unknownNames
+	return result
+}
+
+// validateCategories 업로드할 카테고리를 찾고 strict 모드와 이미지당 최대 카테고리 수 검증
+func (s *imageService) validateCategories(categoryNames []string, strictCategories bool) ([]models.Category, []string, error) {
+	categories, unknownNames, err := s.resolveCategories(categoryNames)
+	if err != nil {
+		return nil, nil, fmt.Errorf("카테고리를 가져오는데 실패했습니다: %v", err)
+	}
+	if strictCategories && len(unknownNames) > 0 {
+		return nil, nil, &UnknownCategoriesError{Names: unknownNames}
+	}
+	if len(categories) > maxCategoriesPerImage {
+		return nil, nil, ErrTooManyCategories
+	}
+	return categories, unknownNames, nil
+}
+
+// saveImage 원본과 썸네일을 저장소에 저장하고 메타데이터, 카테고리 매핑을 DB에 저장
+func (s *imageService) saveImage(r io.Reader, fileName, description string, userID uint, categories []models.Category) (*models.Image, error) {
+	uploadImage, size, err := s.storeImage(r, fileName, description, userID, categories)
+	metrics.ObserveUpload(size, err)
+	return uploadImage, err
+}
+
+// storeImage 원본과 썸네일을 저장하고 메타데이터와 카테고리를 기록, 저장한 원본 크기를 함께 반환
+func (s *imageService) storeImage(r io.Reader, fileName, description string, userID uint, categories []models.Category) (*models.Image, int64, error) {
+	// 유저별 디렉토리에 저장, 같은 이름의 파일을 다시 올려도 덮어쓰지 않도록 저장 파일명 앞에 랜덤 값을 붙임
+	storageName, err := uniqueStorageName(fileName)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
+	saveDir := filepath.Join(s.uploadsDir, strconv.FormatUint(uint64(userID), 10))
+	filePath := filepath.Join(saveDir, storageName)
 
-	// 카테고리 검색 및 추가
-	categories, err := s.categoryRepo.GetCategoriesByName(categoryNames)
+	// 이미지 파일 저장, 저장하면서 원본 파일 해시를 계산해서 이미지 조회 시 ETag로 사용
+	hash := sha256.New()
+	size, err := s.storage.Save(filePath, io.TeeReader(r, hash))
 	if err != nil {
-		return nil, fmt.Errorf("카테고리를 가져오는데 실패했습니다: %v", err)
+		return nil, size, fmt.Errorf("이미지를 저장하는데 실패했습니다: %v", err)
+	}
+
+	// 썸네일 생성
+	thumbnailStart := time.Now()
+	thumbPath, err := s.createThumbnail(filePath, saveDir, storageName)
+	metrics.ObserveThumbnail(time.Since(thumbnailStart), err)
+	if err != nil {
+		_ = s.storage.Remove(filePath) // 이미지가 아닌 파일은 남기지 않음
+		return nil, size, err
 	}
 
 	// 이미지 메타데이터, 썸네일 경로 생성 및 저장
@@ -66,32 +231,98 @@ func (s *imageService) UploadImage(ctx *gin.Context, fileName, description strin
 		FileName:      fileName,
 		FilePath:      filePath,
 		ThumbnailPath: thumbPath,
+		ContentHash:   hex.EncodeToString(hash.Sum(nil)),
 		UploadDate:    time.Now(),
 		Description:   description,
 		UserID:        userID,
 	}
 
-	if err := s.imageRepo.CreateImageMetaData(&uploadImage); err != nil {
-		return nil, fmt.Errorf("이미지 메타데이터를 저장하는데 실패했습니다: %v", err)
+	// 메타데이터와 카테고리 매핑은 한 트랜잭션으로 저장하고, 실패하면 저장한 원본과 썸네일도 남기지 않음
+	categoryIDs := make([]uint, 0, len(categories))
+	for _, category := range categories {
+		categoryIDs = append(categoryIDs, category.ID)
+	}
+	if err := s.imageRepo.CreateImageMetaData(&uploadImage, categoryIDs); err != nil {
+		_ = s.storage.Remove(filePath)
+		_ = s.storage.Remove(thumbPath)
+		return nil, size, fmt.Errorf("이미지 메타데이터를 저장하는데 실패했습니다: %v", err)
+	}
+
+	return &uploadImage, size, nil
+}
+
+// resolveCategories 카테고리명을 대소문자 구분 없이 카테고리와 별칭에서 찾고, 찾지 못한 이름을 함께 반환
+func (s *imageService) resolveCategories(categoryNames []string) ([]models.Category, []string, error) {
+	// 대문자로 정규화한 이름 -> 요청에 들어온 원래 이름
+	requested := make(map[string]string, len(categoryNames))
+	var normalizedNames []string
+	for _, name := range categoryNames {
+		normalized := strings.ToUpper(strings.TrimSpace(name))
+		if normalized == "" {
+			continue
+		}
+		if _, ok := requested[normalized]; ok {
+			continue
+		}
+		requested[normalized] = name
+		normalizedNames = append(normalizedNames, normalized)
+	}
+	if len(normalizedNames) == 0 {
+		return nil, nil, nil
 	}
 
-	// 카테고리 매핑을 위한 image_categories 테이블 업데이트
+	matched := make(map[string]models.Category, len(normalizedNames))
+	categories, err := s.categoryRepo.GetCategoriesByName(normalizedNames)
+	if err != nil {
+		return nil, nil, err
+	}
 	for _, category := range categories {
-		if err := s.imageCategoryRepo.AddImageCategory(uploadImage.ID, category.ID); err != nil {
-			return nil, fmt.Errorf("이미지-카테고리 매핑 업데이트를 실패했습니다: %v", err)
+		matched[strings.ToUpper(category.Name)] = category
+	}
+
+	// 카테고리명으로 찾지 못한 이름은 별칭으로 검색
+	var unmatched []string
+	for _, name := range normalizedNames {
+		if _, ok := matched[name]; !ok {
+			unmatched = append(unmatched, name)
+		}
+	}
+	if len(unmatched) > 0 {
+		aliases, err := s.categoryRepo.GetCategoryAliases(unmatched)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, alias := range aliases {
+			matched[strings.ToUpper(alias.Alias)] = alias.Category
 		}
 	}
 
-	return &uploadImage, nil
+	// 별칭 때문에 여러 이름이 같은 카테고리를 가리킬 수 있으므로 카테고리 ID로 중복 제거
+	var resolved []models.Category
+	var unknownNames []string
+	seen := make(map[uint]struct{}, len(matched))
+	for _, name := range normalizedNames {
+		category, ok := matched[name]
+		if !ok {
+			unknownNames = append(unknownNames, requested[name])
+			continue
+		}
+		if _, ok := seen[category.ID]; ok {
+			continue
+		}
+		seen[category.ID] = struct{}{}
+		resolved = append(resolved, category)
+	}
+	return resolved, unknownNames, nil
 }
 
 // 썸네일 생성 로직
 func (s *imageService) createThumbnail(filePath, saveDir, fileName string) (string, error) {
-	file, err := os.Open(filePath)
+	file, err := s.storage.Open(filePath)
 	if err != nil {
 		return "", fmt.Errorf("썸네일 생성을 위해 이미지 파일을 여는데 실패했습니다: %v", err)
 	}
-	defer func(file *os.File) {
+	defer func(file io.ReadCloser) {
 		err := file.Close()
 		if err != nil {
 			fmt.Printf("썸네일 생성을 위해 이미지 파일을 닫는데 실패했습니다: %v\n", err)
@@ -103,38 +334,33 @@ func (s *imageService) createThumbnail(filePath, saveDir, fileName string) (stri
 		return "", fmt.Errorf("썸네일 생성을 위해 이미지 디코딩이 실패했습니다: %v", err)
 	}
 
-	// 리사이즈 (예: 150x150 픽셀)
-	thumbnail := resize.Thumbnail(150, 150, img, resize.Lanczos3)
+	// 설정한 썸네일 크기 안에 들어오도록 비율을 유지해서 리사이즈
+	thumbnail := resize.Thumbnail(s.imageConfig.ThumbnailWidth, s.imageConfig.ThumbnailHeight, img, resize.Lanczos3)
 
 	// 썸네일 저장 경로
 	thumbFileName := "thumb_" + fileName
 	thumbPath := filepath.Join(saveDir, thumbFileName)
 
-	thumbFile, err := os.Create(thumbPath)
-	if err != nil {
+	var thumbBuf bytes.Buffer
+	if err := jpeg.Encode(&thumbBuf, thumbnail, nil); err != nil {
 		return "", fmt.Errorf("썸네일 이미지 생성에 실패했습니다: %v", err)
 	}
-	defer func(thumbFile *os.File) {
-		err := thumbFile.Close()
-		if err != nil {
-			fmt.Printf("썸네일 이미지 파일을 닫는데 실패했습니다: %v\n", err)
-		}
-	}(thumbFile)
-
-	if err := jpeg.Encode(thumbFile, thumbnail, nil); err != nil {
+	if _, err := s.storage.Save(thumbPath, &thumbBuf); err != nil {
 		return "", fmt.Errorf("썸네일 저장에 실패했습니다: %v", err)
 	}
 
 	return thumbPath, nil
 }
 
-// GetThumbnail 썸네일 경로 반환
-func (s *imageService) GetThumbnail(imageID uint) (string, error) {
-	uploadedImage, err := s.imageRepo.GetImageByID(imageID)
-	if err != nil {
-		return "", fmt.Errorf("썸네일 - 이미지를 가져오는데 실패했습니다: %v", err)
+// GetThumbnail 썸네일을 조회할 이미지 반환
+func (s *imageService) GetThumbnail(imageID uint, userID uint, isAdmin bool) (*models.Image, error) {
+	if !isAdmin {
+		if err := utils.ValidateImagePermission(s.imageRepo, s.permissionRepo, imageID, userID, utils.PermissionView); err != nil {
+			return nil, err
+		}
 	}
-	return uploadedImage.ThumbnailPath, nil
+
+	return utils.GetImage(s.imageRepo, imageID)
 }
 
 // GetAllImages 모든 이미지 목록 조회
@@ -158,12 +384,37 @@ func (s *imageService) GetImagesByUserID(userID uint) ([]models.Image, error) {
 // GetImageByID imageID로 이미지 조회
 func (s *imageService) GetImageByID(imageID uint, userID uint, isAdmin bool) (*models.Image, error) {
 	if !isAdmin {
-		if err := s.validateImageOwnership(imageID, userID); err != nil {
+		if err := utils.ValidateImagePermission(s.imageRepo, s.permissionRepo, imageID, userID, utils.PermissionView); err != nil {
 			return nil, err
 		}
 	}
 
-	return s.imageRepo.GetImageByID(imageID)
+	return utils.GetImage(s.imageRepo, imageID)
+}
+
+// GetContentHash 원본 파일의 SHA-256 해시 반환
+// 해시가 저장되지 않은 이전 이미지는 원본을 한 번 읽어서 계산한 해시를 저장하고, 다음 요청부터는 저장된 해시 사용
+func (s *imageService) GetContentHash(image *models.Image) (string, error) {
+	if image.ContentHash != "" {
+		return image.ContentHash, nil
+	}
+
+	file, err := s.storage.Open(image.FilePath)
+	if err != nil {
+		return "", fmt.Errorf("원본 파일을 여는데 실패했습니다: %v", err)
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("원본 파일의 해시를 계산하는데 실패했습니다: %v", err)
+	}
+
+	contentHash := hex.EncodeToString(hash.Sum(nil))
+	if err := s.imageRepo.UpdateImageContentHash(image.ID, contentHash); err != nil {
+		return "", fmt.Errorf("원본 파일의 해시를 저장하는데 실패했습니다: %v", err)
+	}
+	image.ContentHash = contentHash
+	return contentHash, nil
 }
 
 // GetImagesByCategoryIDAndUserID 특정 카테고리를 가진 사용자의 이미지 조회
@@ -178,7 +429,7 @@ func (s *imageService) GetImagesByCategoryIDAndUserID(categoryID, userID uint, i
 // DeleteImageByID imageID로 개별 이미지 삭제
 func (s *imageService) DeleteImageByID(imageID uint, userID uint, isAdmin bool) error {
 	if !isAdmin {
-		if err := s.validateImageOwnership(imageID, userID); err != nil {
+		if err := utils.ValidateImagePermission(s.imageRepo, s.permissionRepo, imageID, userID, utils.PermissionOwner); err != nil {
 			return err
 		}
 	}
@@ -205,42 +456,58 @@ func (s *imageService) DeleteAllImagesByUserID(userID uint) error {
 	}
 
 	// 파일 시스템에서 이미지 파일 및 썸네일 병렬 삭제
-	errChan := make(chan error, len(images)) // 고루틴 에러를 수집할 채널
-	var wg sync.WaitGroup
-
-	for _, img := range images {
-		wg.Add(1)
-		go func(img models.Image) {
-			defer wg.Done()
-			image := pool.Get().(*models.Image)
-			*image = img
-			if err := s.deleteImageFiles(image); err != nil {
-				errChan <- err // 에러 발생 시 채널에 전송
-			}
-			pool.Put(image)
-		}(img)
-	}
+	// DB에서는 이미 삭제됐으므로 종료 신호를 받아도 멈추지 않고 끝까지 삭제해야 파일이 남지 않음
+	s.background.Go("사용자 이미지 파일 삭제", func(context.Context) {
+		errChan := make(chan error, len(images)) // 고루틴 에러를 수집할 채널
+		var wg sync.WaitGroup
+
+		for _, img := range images {
+			wg.Add(1)
+			metrics.ActiveImageFileDeletions.Inc()
+			go func(img models.Image) {
+				defer wg.Done()
+				defer metrics.ActiveImageFileDeletions.Dec()
+				image := pool.Get().(*models.Image)
+				*image = img
+				if err := s.deleteImageFiles(image); err != nil {
+					errChan <- err // 에러 발생 시 채널에 전송
+				}
+				pool.Put(image)
+			}(img)
+		}
 
-	// 모든 고루틴이 종료되면 채널 닫기
-	go func() {
+		// 모든 고루틴이 종료되면 채널 닫기
 		wg.Wait()
 		close(errChan)
-	}()
+		for err := range errChan {
+			log.Printf("사용자 %d의 이미지 파일 삭제가 실패했습니다: %v", userID, err)
+		}
+	})
 
 	return nil
 }
 
+// uniqueStorageName 저장소에 저장할 파일명, 원본 파일명 앞에 랜덤 값을 붙여 사용자 디렉토리 안에서 겹치지 않도록 함
+// 원본 파일명은 메타데이터(FileName)에 그대로 저장되므로 다운로드할 때는 원래 이름을 사용
+func uniqueStorageName(fileName string) (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("저장할 파일명을 만드는데 실패했습니다: %v", err)
+	}
+	return hex.EncodeToString(buf) + "_" + filepath.Base(fileName), nil
+}
+
 // deleteImageFiles 파일 시스템에서 이미지 및 썸네일 파일 삭제
 func (s *imageService) deleteImageFiles(image *models.Image) error {
 	var deleteErrors []error
 
 	// 이미지 파일 삭제
-	if err := os.Remove(image.FilePath); err != nil && !os.IsNotExist(err) {
+	if err := s.storage.Remove(image.FilePath); err != nil {
 		deleteErrors = append(deleteErrors, fmt.Errorf("서버에서 이미지 삭제를 실패했습니다: %v", err))
 	}
 
 	// 썸네일 파일 삭제
-	if err := os.Remove(image.ThumbnailPath); err != nil && !os.IsNotExist(err) {
+	if err := s.storage.Remove(image.ThumbnailPath); err != nil {
 		deleteErrors = append(deleteErrors, fmt.Errorf("서버에서 썸네일 삭제를 실패했습니다: %v", err))
 	}
 
@@ -250,7 +517,3 @@ func (s *imageService) deleteImageFiles(image *models.Image) error {
 	}
 	return nil
 }
-
-func (s *imageService) validateImageOwnership(imageID, userID uint) error {
-	return utils.ValidateImageOwnership(s.imageRepo, imageID, userID)
-}
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/models"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	maxImportBytes     = 20 << 20 // URL로 가져올 수 있는 최대 이미지 크기 (20MB)
+	maxImportRedirects = 3
+	importTimeout      = 30 * time.Second // 요청 전체 제한 시간
+	importDialTimeout  = 5 * time.Second
+)
+
+var (
+	ErrInvalidImportURL  = errors.New("잘못된 이미지 URL입니다")
+	ErrImportBlockedHost = errors.New("내부 네트워크 주소의 이미지는 가져올 수 없습니다")
+	ErrImportTooLarge    = errors.New("가져올 수 있는 최대 이미지 크기를 넘었습니다")
+	ErrImportNotImage    = errors.New("이미지 파일이 아닙니다")
+	ErrImportFailed      = errors.New("이미지를 가져오는데 실패했습니다")
+)
+
+// 공인 IP가 아닌 주소 대역, allow-list에 없으면 접근을 막아 SSRF를 방지
+var blockedNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"), // CGNAT
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"), // NAT64
+}
+
+// 확장자가 없는 URL에서 가져온 이미지에 붙일 확장자
+var importExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+type importService struct {
+	imageService    ImageService
+	allowedNetworks []netip.Prefix
+	client          *http.Client
+}
+
+// NewImportService allowedNetworks 대역은 내부 네트워크 주소라도 이미지를 가져올 수 있음
+func NewImportService(imageService ImageService, allowedNetworks []netip.Prefix) ImportService {
+	s := &importService{imageService: imageService, allowedNetworks: allowedNetworks}
+
+	// 연결할 때 DNS 조회가 끝난 실제 IP를 검사해서 DNS rebinding과 리다이렉트로 우회하는 것도 막음
+	dialer := &net.Dialer{
+		Timeout: importDialTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return ErrImportBlockedHost
+			}
+			return s.checkAddress(addrPort.Addr())
+		},
+	}
+	s.client = &http.Client{
+		Timeout: importTimeout,
+		Transport: &http.Transport{
+			Proxy:                 nil, // 프록시를 거치면 IP 검사를 우회할 수 있으므로 환경변수 프록시를 사용하지 않음
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   importDialTimeout,
+			ResponseHeaderTimeout: 10 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxImportRedirects {
+				return fmt.Errorf("리다이렉트가 너무 많습니다")
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return ErrInvalidImportURL
+			}
+			return nil
+		},
+	}
+	return s
+}
+
+// ImportImageFromURL URL의 이미지를 내려받아 일반 업로드와 같은 흐름으로 저장
+func (s *importService) ImportImageFromURL(ctx context.Context, rawURL, description string, userID uint, categoryNames []string, strictCategories bool) (*models.Image, []string, error) {
+	imageURL, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (imageURL.Scheme != "http" && imageURL.Scheme != "https") || imageURL.Hostname() == "" {
+		return nil, nil, ErrInvalidImportURL
+	}
+
+	data, contentType, err := s.download(ctx, imageURL.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fileName, err := importFileName(imageURL, contentType)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.imageService.UploadImageFromReader(bytes.NewReader(data), fileName, description, userID, categoryNames, strictCategories)
+}
+
+// download 최대 maxImportBytes까지 내려받고, 내용으로 판단한 Content-Type과 함께 반환
+func (s *importService) download(ctx context.Context, imageURL string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return nil, "", ErrInvalidImportURL
+	}
+	req.Header.Set("Accept", "image/*")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		if errors.Is(err, ErrImportBlockedHost) {
+			return nil, "", ErrImportBlockedHost
+		}
+		return nil, "", fmt.Errorf("%w: %v", ErrImportFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("%w: 응답 상태 코드 %d", ErrImportFailed, resp.StatusCode)
+	}
+	if resp.ContentLength > maxImportBytes {
+		return nil, "", ErrImportTooLarge
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImportBytes+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrImportFailed, err)
+	}
+	if len(data) > maxImportBytes {
+		return nil, "", ErrImportTooLarge
+	}
+
+	// 응답 헤더의 Content-Type은 신뢰하지 않고 내용으로 판단
+	contentType := http.DetectContentType(data)
+	if !strings.HasPrefix(contentType, "image/") {
+		return nil, "", ErrImportNotImage
+	}
+	return data, contentType, nil
+}
+
+// checkAddress allow-list에 없는 내부 네트워크 주소인지 검사
+func (s *importService) checkAddress(addr netip.Addr) error {
+	addr = addr.Unmap()
+	for _, prefix := range s.allowedNetworks {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return ErrImportBlockedHost
+	}
+	for _, prefix := range blockedNetworks {
+		if prefix.Contains(addr) {
+			return ErrImportBlockedHost
+		}
+	}
+	return nil
+}
+
+// importFileName URL 경로의 마지막 부분을 파일명으로 사용하고, 확장자가 내용과 다르거나 없으면 Content-Type으로 추가
+// 경로에 파일명이 없는 URL은 가져올 때마다 다른 이름을 만들어서 같은 image.jpg가 여러 개 생기지 않도록 함
+// 저장 경로는 파일명과 상관없이 업로드할 때 겹치지 않게 만들어지므로 이름이 같은 URL을 가져와도 덮어쓰지 않음
+func importFileName(imageURL *url.URL, contentType string) (string, error) {
+	fileName := path.Base(imageURL.Path)
+	if fileName == "." || fileName == "/" || fileName == ".." {
+		buf := make([]byte, 4)
+		if _, err := rand.Read(buf); err != nil {
+			return "", fmt.Errorf("파일명을 만드는데 실패했습니다: %v", err)
+		}
+		fileName = fmt.Sprintf("image_%s_%s", time.Now().Format("20060102150405"), hex.EncodeToString(buf))
+	}
+
+	extension := importExtensions[contentType]
+	switch strings.ToLower(path.Ext(fileName)) {
+	case extension:
+	case ".jpeg":
+		if extension != ".jpg" {
+			fileName += extension
+		}
+	default:
+		fileName += extension
+	}
+	return fileName, nil
+}
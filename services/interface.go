@@ -1,8 +1,12 @@
 package services
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/zeze1004/image-hub-platform/models"
+	"io"
+	"io/fs"
+	"time"
 )
 
 type AuthService interface {
@@ -11,11 +15,14 @@ type AuthService interface {
 }
 
 type ImageService interface {
-	UploadImage(ctx *gin.Context, fileName, description string, userID uint, categoryNames []string) (*models.Image, error)
-	GetThumbnail(imageID uint) (string, error)
+	UploadImage(ctx *gin.Context, fileName, description string, userID uint, categoryNames []string, strictCategories bool) (*models.Image, []string, error)
+	UploadImageFromReader(r io.Reader, fileName, description string, userID uint, categoryNames []string, strictCategories bool) (*models.Image, []string, error)
+	UploadImages(files []BatchUploadFile, userID uint, strictCategories bool) ([]BatchUploadResult, error)
+	GetThumbnail(imageID uint, userID uint, isAdmin bool) (*models.Image, error)
 	GetAllImages() ([]models.Image, error)
 	GetImagesByUserID(userID uint) ([]models.Image, error)
 	GetImageByID(imageID uint, userID uint, isAdmin bool) (*models.Image, error)
+	GetContentHash(image *models.Image) (string, error)
 	DeleteImageByID(imageID uint, userID uint, isAdmin bool) error
 	DeleteAllImagesByUserID(userID uint) error
 	GetImagesByCategoryIDAndUserID(categoryID, userID uint, isAdmin bool) ([]models.Image, error)
@@ -25,4 +32,82 @@ type CategoryService interface {
 	GetCategoriesByImageIDAndUserID(imageID, userID uint, isAdmin bool) ([]models.Category, error)
 	AddCategoryToImageByImageIDAndCategoryID(imageID, categoryID, userID uint, isAdmin bool) error
 	RemoveCategoryFromImageByImageIDAndCategoryID(imageID, categoryID, userID uint, isAdmin bool) error
+	BulkUpdateImageCategories(action BulkCategoryAction, imageIDs, categoryIDs []uint, userID uint, isAdmin bool) ([]BulkCategoryResult, error)
+	GetCategoryStats(userID uint, from, to time.Time) (*models.CategoryStats, error)
+}
+
+type TagService interface {
+	AddTagsToImage(imageID, userID uint, isAdmin bool, tagNames []string) ([]models.Tag, error)
+	RemoveTagFromImage(imageID, userID uint, isAdmin bool, tagName string) error
+	GetTagsByImageID(imageID, userID uint, isAdmin bool) ([]models.Tag, error)
+	SearchTagsByPrefix(prefix string, userID uint, isAdmin bool, limit int) ([]models.Tag, error)
+	GetImagesByTagName(tagName string, userID uint, isAdmin bool) ([]models.Image, error)
+}
+
+type AlbumService interface {
+	CreateAlbum(ownerID uint, title string) (*models.Album, error)
+	GetAlbumsByUserID(userID uint) ([]models.AlbumSummary, error)
+	GetAlbum(albumID, userID uint, isAdmin bool) (*models.AlbumDetail, error)
+	UpdateAlbum(albumID, userID uint, isAdmin bool, title *string, coverImageID *uint) (*models.Album, error)
+	DeleteAlbum(albumID, userID uint, isAdmin bool) error
+	AddImagesToAlbum(albumID, userID uint, isAdmin bool, imageIDs []uint) error
+	RemoveImageFromAlbum(albumID, imageID, userID uint, isAdmin bool) error
+	ReorderAlbumImages(albumID, userID uint, isAdmin bool, imageIDs []uint) error
+}
+
+type ShareService interface {
+	CreateShare(imageID, userID uint, isAdmin bool, opts ShareOptions) (*models.ImageShare, string, error)
+	GetSharesByImageID(imageID, userID uint, isAdmin bool) ([]models.ImageShare, error)
+	RevokeShare(imageID, shareID, userID uint, isAdmin bool) error
+	OpenShare(token, password string) (*models.Image, error)
+	OpenShareThumbnail(token, password string) (*models.Image, error)
+}
+
+type PermissionService interface {
+	GrantPermission(imageID, userID uint, isAdmin bool, email, role string) (*models.ImagePermission, error)
+	RevokePermission(imageID, granteeID, userID uint, isAdmin bool) error
+	GetPermissionsByImageID(imageID, userID uint, isAdmin bool) ([]models.ImagePermission, error)
+	GetImagesSharedWithUser(userID uint) ([]models.SharedImage, error)
+}
+
+type UploadService interface {
+	CreateUpload(userID uint, length int64, metadata UploadMetadata) (*models.UploadSession, error)
+	GetUpload(uploadID string, userID uint) (*models.UploadSession, error)
+	WriteChunk(uploadID string, userID uint, offset int64, r io.Reader) (*models.UploadSession, *models.Image, []string, error)
+	DeleteUpload(uploadID string, userID uint) error
+	PurgeExpiredUploads() (int, error)
+}
+
+type ImportService interface {
+	ImportImageFromURL(ctx context.Context, rawURL, description string, userID uint, categoryNames []string, strictCategories bool) (*models.Image, []string, error)
+}
+
+type ArchiveService interface {
+	GetArchiveImages(filter ArchiveFilter, userID uint, isAdmin bool) ([]models.Image, error)
+	WriteArchive(w io.Writer, images []models.Image, options ArchiveOptions) error
+}
+
+type ExportService interface {
+	RequestExport(userID uint, includeRenditions bool) (*models.ExportJob, error)
+	GetExportJob(jobID, userID uint) (*models.ExportJob, error)
+	GetExportJobs(userID uint) ([]models.ExportJob, error)
+	Run(ctx context.Context)
+	PurgeExpiredExports() (int, error)
+}
+
+type BulkImportService interface {
+	LoadSidecar(fsys fs.FS) ([]BulkImportItem, error)
+	ParseSidecar(r io.Reader, name string) ([]BulkImportItem, error)
+	ImportImages(ctx context.Context, fsys fs.FS, items []BulkImportItem, workers int, onResult func(BulkImportResult)) []BulkImportResult
+}
+
+type BulkImportJobService interface {
+	RequestImport(userID uint, archive io.ReaderAt, archiveSize int64, metadata io.Reader, metadataName string) (*models.BulkImportJob, error)
+	GetImportJob(jobID uint) (*models.BulkImportJob, error)
+	Run(ctx context.Context)
+	PurgeExpiredImports() (int, error)
+}
+
+type HealthService interface {
+	Readiness(ctx context.Context) Readiness
 }
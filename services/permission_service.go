@@ -0,0 +1,101 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"strings"
+)
+
+var (
+	ErrInvalidPermissionRequest = errors.New("잘못된 권한 요청입니다")
+	ErrPermissionNotFound       = errors.New("부여된 권한이 없습니다")
+)
+
+type permissionService struct {
+	imageRepo      repositories.ImageRepository
+	userRepo       repositories.UserRepository
+	permissionRepo repositories.PermissionRepository
+}
+
+func NewPermissionService(imageRepo repositories.ImageRepository, userRepo repositories.UserRepository, permissionRepo repositories.PermissionRepository) PermissionService {
+	return &permissionService{imageRepo: imageRepo, userRepo: userRepo, permissionRepo: permissionRepo}
+}
+
+// GrantPermission 이메일로 지정한 사용자에게 이미지 조회(VIEWER) 또는 수정(EDITOR) 권한 부여
+func (s *permissionService) GrantPermission(imageID, userID uint, isAdmin bool, email, role string) (*models.ImagePermission, error) {
+	image, err := s.getImage(imageID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	role = strings.ToUpper(strings.TrimSpace(role))
+	if role != models.ImageRoleViewer && role != models.ImageRoleEditor {
+		return nil, fmt.Errorf("%w: 역할은 %s 또는 %s만 가능합니다", ErrInvalidPermissionRequest, models.ImageRoleViewer, models.ImageRoleEditor)
+	}
+
+	grantee, err := s.userRepo.GetUserByEmail(email)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 가입되지 않은 메일입니다", ErrInvalidPermissionRequest)
+	}
+	if grantee.ID == image.UserID {
+		return nil, fmt.Errorf("%w: 이미지 소유자에게는 권한을 부여할 수 없습니다", ErrInvalidPermissionRequest)
+	}
+
+	permission := models.ImagePermission{
+		ImageID:   imageID,
+		UserID:    grantee.ID,
+		Role:      role,
+		GrantedBy: userID,
+	}
+	if err := s.permissionRepo.GrantPermission(&permission); err != nil {
+		return nil, fmt.Errorf("권한을 부여하는데 실패했습니다: %v", err)
+	}
+	return &permission, nil
+}
+
+// RevokePermission granteeID 사용자에게 부여한 이미지 권한 회수
+func (s *permissionService) RevokePermission(imageID, granteeID, userID uint, isAdmin bool) error {
+	if _, err := s.getImage(imageID, userID, isAdmin); err != nil {
+		return err
+	}
+
+	revoked, err := s.permissionRepo.RevokePermission(imageID, granteeID)
+	if err != nil {
+		return fmt.Errorf("권한을 회수하는데 실패했습니다: %v", err)
+	}
+	if revoked == 0 {
+		return ErrPermissionNotFound
+	}
+	return nil
+}
+
+// GetPermissionsByImageID 이미지에 부여된 권한 목록 조회
+func (s *permissionService) GetPermissionsByImageID(imageID, userID uint, isAdmin bool) ([]models.ImagePermission, error) {
+	if _, err := s.getImage(imageID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.permissionRepo.GetPermissionsByImageID(imageID)
+}
+
+// GetImagesSharedWithUser 다른 사용자에게 공유받은 이미지 목록 조회
+func (s *permissionService) GetImagesSharedWithUser(userID uint) ([]models.SharedImage, error) {
+	images, err := s.permissionRepo.GetImagesSharedWithUser(userID)
+	if err != nil {
+		return nil, fmt.Errorf("공유받은 이미지 목록을 가져오는데 실패했습니다: %v", err)
+	}
+	return images, nil
+}
+
+// getImage 권한 관리는 이미지 소유자와 관리자만 가능
+func (s *permissionService) getImage(imageID, userID uint, isAdmin bool) (*models.Image, error) {
+	image, err := s.imageRepo.GetImageByID(imageID)
+	if err != nil {
+		return nil, fmt.Errorf("이미지를 찾을 수 없습니다: %v", err)
+	}
+	if !isAdmin && image.UserID != userID {
+		return nil, fmt.Errorf("이미지에 대한 권한이 없습니다")
+	}
+	return image, nil
+}
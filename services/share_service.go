@@ -0,0 +1,189 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"golang.org/x/crypto/bcrypt"
+	"time"
+)
+
+const (
+	shareTokenBytes   = 32                   // 공유 토큰 원문 바이트 수
+	maxShareLifetime  = 365 * 24 * time.Hour // 공유 링크 최대 유효 기간
+	maxShareViewLimit = 1_000_000            // 최대 조회 수 제한
+)
+
+var (
+	ErrInvalidShareRequest   = errors.New("잘못된 공유 링크 요청입니다")
+	ErrShareNotFound         = errors.New("존재하지 않거나 폐기된 공유 링크입니다")
+	ErrShareExpired          = errors.New("만료된 공유 링크입니다")
+	ErrSharePasswordRequired = errors.New("공유 링크 비밀번호가 일치하지 않습니다")
+)
+
+// ShareOptions 공유 링크 생성 옵션
+type ShareOptions struct {
+	ExpiresAt *time.Time // nil이면 만료되지 않음
+	Password  string     // 빈 문자열이면 비밀번호 없음
+	MaxViews  int        // 0이면 조회 수 제한 없음
+}
+
+type shareService struct {
+	imageRepo repositories.ImageRepository
+	shareRepo repositories.ShareRepository
+}
+
+func NewShareService(imageRepo repositories.ImageRepository, shareRepo repositories.ShareRepository) ShareService {
+	return &shareService{imageRepo: imageRepo, shareRepo: shareRepo}
+}
+
+// CreateShare 이미지 공유 링크 생성, 토큰 원문은 여기서만 반환되므로 응답에 포함해야 함
+func (s *shareService) CreateShare(imageID, userID uint, isAdmin bool, opts ShareOptions) (*models.ImageShare, string, error) {
+	if !isAdmin {
+		if err := s.validateImageOwnership(imageID, userID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if opts.ExpiresAt != nil {
+		lifetime := time.Until(*opts.ExpiresAt)
+		if lifetime <= 0 || lifetime > maxShareLifetime {
+			return nil, "", fmt.Errorf("%w: 만료 시간은 현재 이후 1년 이내여야 합니다", ErrInvalidShareRequest)
+		}
+	}
+	if opts.MaxViews < 0 || opts.MaxViews > maxShareViewLimit {
+		return nil, "", fmt.Errorf("%w: 조회 수 제한은 0 ~ %d 사이여야 합니다", ErrInvalidShareRequest, maxShareViewLimit)
+	}
+
+	token, tokenHash, err := generateShareToken()
+	if err != nil {
+		return nil, "", fmt.Errorf("공유 토큰을 생성하는데 실패했습니다: %v", err)
+	}
+
+	share := models.ImageShare{
+		ImageID:   imageID,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: opts.ExpiresAt,
+		MaxViews:  opts.MaxViews,
+	}
+	if opts.Password != "" {
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(opts.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, "", err
+		}
+		share.PasswordHash = string(hashedPassword)
+		share.HasPassword = true
+	}
+
+	if err := s.shareRepo.CreateShare(&share); err != nil {
+		return nil, "", fmt.Errorf("공유 링크를 저장하는데 실패했습니다: %v", err)
+	}
+	return &share, token, nil
+}
+
+// GetSharesByImageID 이미지의 공유 링크 목록 조회
+func (s *shareService) GetSharesByImageID(imageID, userID uint, isAdmin bool) ([]models.ImageShare, error) {
+	if !isAdmin {
+		if err := s.validateImageOwnership(imageID, userID); err != nil {
+			return nil, err
+		}
+	}
+	return s.shareRepo.GetSharesByImageID(imageID)
+}
+
+// RevokeShare 공유 링크 폐기
+func (s *shareService) RevokeShare(imageID, shareID, userID uint, isAdmin bool) error {
+	if !isAdmin {
+		if err := s.validateImageOwnership(imageID, userID); err != nil {
+			return err
+		}
+	}
+
+	revoked, err := s.shareRepo.RevokeShare(imageID, shareID)
+	if err != nil {
+		return fmt.Errorf("공유 링크를 폐기하는데 실패했습니다: %v", err)
+	}
+	if revoked == 0 {
+		return ErrShareNotFound
+	}
+	return nil
+}
+
+// OpenShare 공유 토큰을 검증하고 조회 수를 올린 뒤 공유된 이미지 반환
+func (s *shareService) OpenShare(token, password string) (*models.Image, error) {
+	share, image, err := s.verifyShare(token, password)
+	if err != nil {
+		return nil, err
+	}
+
+	counted, err := s.shareRepo.IncrementViewCount(share.ID)
+	if err != nil {
+		return nil, fmt.Errorf("공유 링크 조회 수를 갱신하는데 실패했습니다: %v", err)
+	}
+	if !counted {
+		return nil, ErrShareExpired
+	}
+	return image, nil
+}
+
+// OpenShareThumbnail 공유 토큰을 검증하고 공유된 이미지 반환, 미리보기는 조회 수를 올리지 않음
+// 조회 수 제한을 다 쓴 링크는 미리보기도 열 수 없음
+func (s *shareService) OpenShareThumbnail(token, password string) (*models.Image, error) {
+	share, image, err := s.verifyShare(token, password)
+	if err != nil {
+		return nil, err
+	}
+	if share.MaxViews > 0 && share.ViewCount >= share.MaxViews {
+		return nil, ErrShareExpired
+	}
+	return image, nil
+}
+
+// verifyShare 공유 링크의 폐기, 만료, 비밀번호를 검증하고 공유 링크와 공유된 이미지 반환
+func (s *shareService) verifyShare(token, password string) (*models.ImageShare, *models.Image, error) {
+	share, err := s.shareRepo.GetShareByTokenHash(hashShareToken(token))
+	if err != nil || share.RevokedAt != nil {
+		return nil, nil, ErrShareNotFound
+	}
+	if share.ExpiresAt != nil && time.Now().After(*share.ExpiresAt) {
+		return nil, nil, ErrShareExpired
+	}
+	if share.PasswordHash != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(share.PasswordHash), []byte(password)); err != nil {
+			return nil, nil, ErrSharePasswordRequired
+		}
+	}
+
+	// 이미지가 삭제됐다면 조회 수를 올리지 않음
+	image, err := s.imageRepo.GetImageByID(share.ImageID)
+	if err != nil {
+		return nil, nil, ErrShareNotFound
+	}
+	return share, image, nil
+}
+
+func (s *shareService) validateImageOwnership(imageID, userID uint) error {
+	return utils.ValidateImageOwnership(s.imageRepo, imageID, userID)
+}
+
+// generateShareToken URL에 쓸 수 있는 랜덤 토큰과 DB에 저장할 토큰 해시 생성
+func generateShareToken() (string, string, error) {
+	buf := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashShareToken(token), nil
+}
+
+func hashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	maxTagNameLength   = 50 // 태그명 최대 글자 수
+	maxTagsPerImage    = 30 // 이미지 하나에 붙일 수 있는 최대 태그 수
+	defaultTagSuggests = 10 // 자동완성 기본 개수
+	maxTagSuggests     = 50 // 자동완성 최대 개수
+)
+
+var (
+	ErrInvalidTagName = errors.New("잘못된 태그명입니다")
+	ErrTooManyTags    = errors.New("이미지에 붙일 수 있는 태그 수를 초과했습니다")
+	ErrTagNotFound    = errors.New("이미지에 없는 태그입니다")
+)
+
+type tagService struct {
+	imageRepo      repositories.ImageRepository
+	tagRepo        repositories.TagRepository
+	imageTagRepo   repositories.ImageTagRepository
+	permissionRepo repositories.PermissionRepository
+}
+
+func NewTagService(imageRepo repositories.ImageRepository, tagRepo repositories.TagRepository, imageTagRepo repositories.ImageTagRepository, permissionRepo repositories.PermissionRepository) TagService {
+	return &tagService{imageRepo: imageRepo, tagRepo: tagRepo, imageTagRepo: imageTagRepo, permissionRepo: permissionRepo}
+}
+
+// AddTagsToImage 이미지에 태그 추가, 처음 사용되는 태그는 자동 생성
+func (s *tagService) AddTagsToImage(imageID, userID uint, isAdmin bool, tagNames []string) ([]models.Tag, error) {
+	if !isAdmin {
+		if err := utils.ValidateImagePermission(s.imageRepo, s.permissionRepo, imageID, userID, utils.PermissionEdit); err != nil {
+			return nil, err
+		}
+	}
+
+	names, err := normalizeTagNames(tagNames)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%w: 추가할 태그가 없습니다", ErrInvalidTagName)
+	}
+
+	tags, err := s.tagRepo.GetOrCreateTagsByName(names)
+	if err != nil {
+		return nil, fmt.Errorf("태그를 생성하는데 실패했습니다: %v", err)
+	}
+
+	// 이미 붙은 태그는 다시 세지 않고, 동시에 추가해도 최대 개수를 넘지 않도록 이미지를 잠근 트랜잭션에서 개수 확인과 추가를 처리
+	err = s.imageTagRepo.Transaction(func(txRepo repositories.ImageTagRepository) error {
+		if err := txRepo.LockImage(imageID); err != nil {
+			return fmt.Errorf("이미지를 잠그는데 실패했습니다: %v", err)
+		}
+		currentIDs, err := txRepo.GetTagIDsByImageID(imageID)
+		if err != nil {
+			return fmt.Errorf("이미지의 태그를 가져오는데 실패했습니다: %v", err)
+		}
+		attached := make(map[uint]struct{}, len(currentIDs))
+		for _, tagID := range currentIDs {
+			attached[tagID] = struct{}{}
+		}
+		tagIDs := make([]uint, 0, len(tags))
+		for _, tag := range tags {
+			if _, ok := attached[tag.ID]; !ok {
+				tagIDs = append(tagIDs, tag.ID)
+			}
+		}
+		if len(currentIDs)+len(tagIDs) > maxTagsPerImage {
+			return fmt.Errorf("%w: 최대 %d개", ErrTooManyTags, maxTagsPerImage)
+		}
+		if err := txRepo.AddTagsToImage(imageID, tagIDs); err != nil {
+			return fmt.Errorf("이미지-태그 매핑 업데이트를 실패했습니다: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tagRepo.GetTagsByImageID(imageID)
+}
+
+// RemoveTagFromImage 이미지에서 태그 제거
+func (s *tagService) RemoveTagFromImage(imageID, userID uint, isAdmin bool, tagName string) error {
+	if !isAdmin {
+		if err := utils.ValidateImagePermission(s.imageRepo, s.permissionRepo, imageID, userID, utils.PermissionEdit); err != nil {
+			return err
+		}
+	}
+
+	name, err := normalizeTagName(tagName)
+	if err != nil {
+		return err
+	}
+
+	tags, err := s.tagRepo.GetTagsByName([]string{name})
+	if err != nil {
+		return fmt.Errorf("태그를 가져오는데 실패했습니다: %v", err)
+	}
+	if len(tags) == 0 {
+		return ErrTagNotFound
+	}
+
+	removed, err := s.imageTagRepo.RemoveTagFromImage(imageID, tags[0].ID)
+	if err != nil {
+		return fmt.Errorf("이미지에서 태그를 삭제하는데 실패했습니다: %v", err)
+	}
+	if removed == 0 {
+		return ErrTagNotFound
+	}
+	return nil
+}
+
+// GetTagsByImageID 특정 이미지의 태그 조회
+func (s *tagService) GetTagsByImageID(imageID, userID uint, isAdmin bool) ([]models.Tag, error) {
+	if !isAdmin {
+		if err := utils.ValidateImagePermission(s.imageRepo, s.permissionRepo, imageID, userID, utils.PermissionView); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.tagRepo.GetTagsByImageID(imageID)
+}
+
+// SearchTagsByPrefix 접두어로 태그 자동완성
+// 태그는 모든 사용자가 함께 쓰므로, 관리자가 아니면 소유하거나 권한을 받은 이미지에 붙은 태그만 조회
+func (s *tagService) SearchTagsByPrefix(prefix string, userID uint, isAdmin bool, limit int) ([]models.Tag, error) {
+	if limit <= 0 {
+		limit = defaultTagSuggests
+	}
+	if limit > maxTagSuggests {
+		limit = maxTagSuggests
+	}
+
+	// 입력 중인 접두어도 저장된 태그명과 같은 규칙으로 정규화
+	prefix = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(prefix), "#"))
+	if prefix == "" {
+		return []models.Tag{}, nil
+	}
+
+	if !isAdmin {
+		return s.tagRepo.GetTagsByPrefixAndUserID(prefix, userID, limit)
+	}
+	return s.tagRepo.GetTagsByPrefix(prefix, limit)
+}
+
+// GetImagesByTagName 특정 태그가 붙은 이미지 조회
+func (s *tagService) GetImagesByTagName(tagName string, userID uint, isAdmin bool) ([]models.Image, error) {
+	name, err := normalizeTagName(tagName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAdmin {
+		return s.tagRepo.GetImagesByTagNameAndUserID(name, userID)
+	}
+	return s.tagRepo.GetImagesByTagName(name)
+}
+
+// normalizeTagNames 태그명 목록을 정규화하고 중복 제거
+func normalizeTagNames(tagNames []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(tagNames))
+	names := make([]string, 0, len(tagNames))
+	for _, tagName := range tagNames {
+		name, err := normalizeTagName(tagName)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// normalizeTagName 앞의 '#'과 공백을 제거하고 소문자로 변환
+// 태그명은 문자, 숫자, '_', '-'만 허용
+func normalizeTagName(tagName string) (string, error) {
+	name := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tagName), "#"))
+	if name == "" || utf8.RuneCountInString(name) > maxTagNameLength {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTagName, tagName)
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' {
+			return "", fmt.Errorf("%w: %q", ErrInvalidTagName, tagName)
+		}
+	}
+	return name, nil
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	MaxUploadLength = 100 << 20 // 이어올리기로 업로드할 수 있는 최대 파일 크기 (100MB)
+
+	uploadTTL     = 24 * time.Hour // 마지막으로 이어쓴 뒤 이 시간 동안 완료되지 않은 업로드는 삭제
+	uploadPartDir = ".partial"     // 업로드 디렉토리 안에서 업로드 중인 파일을 저장하는 디렉토리
+	uploadIDBytes = 16
+)
+
+var (
+	ErrInvalidUploadRequest = errors.New("잘못된 업로드 요청입니다")
+	ErrUploadNotFound       = errors.New("업로드를 찾을 수 없습니다")
+	ErrUploadExpired        = errors.New("만료된 업로드입니다")
+	ErrUploadOffsetMismatch = errors.New("업로드 위치가 현재 업로드된 크기와 다릅니다")
+	ErrUploadTooLarge       = errors.New("업로드할 수 있는 최대 파일 크기를 넘었습니다")
+)
+
+// UploadMetadata 업로드 생성 시 함께 받는 이미지 정보
+type UploadMetadata struct {
+	FileName         string
+	Description      string
+	Categories       []string
+	StrictCategories bool
+}
+
+type uploadService struct {
+	uploadRepo   repositories.UploadRepository
+	imageService ImageService
+	storage      storage.Storage
+	uploadsDir   string
+	locks        sync.Map // 업로드 ID -> *sync.Mutex, 같은 업로드에 동시에 이어쓰지 않도록 함
+}
+
+func NewUploadService(uploadRepo repositories.UploadRepository, imageService ImageService, fileStorage storage.Storage, storageConfig config.StorageConfig) UploadService {
+	return &uploadService{uploadRepo: uploadRepo, imageService: imageService, storage: fileStorage, uploadsDir: storageConfig.UploadsDir}
+}
+
+// CreateUpload 이어올리기 업로드 생성, 빈 임시 파일을 만들고 업로드 정보 저장
+func (s *uploadService) CreateUpload(userID uint, length int64, metadata UploadMetadata) (*models.UploadSession, error) {
+	if length <= 0 {
+		return nil, fmt.Errorf("%w: 파일 크기가 필요합니다", ErrInvalidUploadRequest)
+	}
+	if length > MaxUploadLength {
+		return nil, ErrUploadTooLarge
+	}
+	fileName := filepath.Base(strings.TrimSpace(metadata.FileName))
+	if fileName == "." || fileName == ".." || fileName == string(filepath.Separator) {
+		return nil, fmt.Errorf("%w: 파일명이 필요합니다", ErrInvalidUploadRequest)
+	}
+
+	uploadID, err := generateUploadID()
+	if err != nil {
+		return nil, fmt.Errorf("업로드 ID를 만드는데 실패했습니다: %v", err)
+	}
+	if _, err := s.storage.Save(s.uploadPartPath(uploadID), bytes.NewReader(nil)); err != nil {
+		return nil, fmt.Errorf("업로드 임시 파일을 만드는데 실패했습니다: %v", err)
+	}
+
+	upload := models.UploadSession{
+		ID:               uploadID,
+		UserID:           userID,
+		FileName:         fileName,
+		Description:      metadata.Description,
+		Categories:       strings.Join(metadata.Categories, ","),
+		StrictCategories: metadata.StrictCategories,
+		Length:           length,
+		ExpiresAt:        time.Now().Add(uploadTTL),
+	}
+	if err := s.uploadRepo.CreateUpload(&upload); err != nil {
+		_ = s.storage.Remove(s.uploadPartPath(uploadID))
+		return nil, fmt.Errorf("업로드 정보를 저장하는데 실패했습니다: %v", err)
+	}
+	return &upload, nil
+}
+
+// GetUpload 업로드 정보 조회, 업로드된 크기는 임시 파일 크기로 설정
+func (s *uploadService) GetUpload(uploadID string, userID uint) (*models.UploadSession, error) {
+	upload, err := s.uploadRepo.GetUploadByID(uploadID)
+	if err != nil || upload.UserID != userID {
+		return nil, ErrUploadNotFound
+	}
+	if time.Now().After(upload.ExpiresAt) {
+		return nil, ErrUploadExpired
+	}
+
+	// 이어쓰기 도중 서버가 종료되면 DB에 기록된 크기보다 임시 파일이 클 수 있으므로 임시 파일 크기를 기준으로 함
+	size, err := s.storage.Size(s.uploadPartPath(uploadID))
+	if err != nil {
+		return nil, fmt.Errorf("업로드 임시 파일을 확인하는데 실패했습니다: %v", err)
+	}
+	upload.Offset = size
+	return upload, nil
+}
+
+// WriteChunk offset 위치부터 r의 내용을 이어쓰기
+// 전체 크기만큼 업로드되면 기존 업로드와 같은 흐름으로 썸네일, 메타데이터, 카테고리를 저장하고 이미지를 반환
+func (s *uploadService) WriteChunk(uploadID string, userID uint, offset int64, r io.Reader) (*models.UploadSession, *models.Image, []string, error) {
+	unlock := s.lock(uploadID)
+	defer unlock()
+
+	upload, err := s.GetUpload(uploadID, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if offset != upload.Offset {
+		return nil, nil, nil, ErrUploadOffsetMismatch
+	}
+
+	// 연결이 끊겨도 받은 만큼은 기록해서 다음 요청에서 이어쓸 수 있도록 함, 전체 크기를 넘는 데이터는 버림
+	written, writeErr := s.storage.Append(s.uploadPartPath(uploadID), io.LimitReader(r, upload.Length-upload.Offset))
+	upload.Offset += written
+	upload.ExpiresAt = time.Now().Add(uploadTTL)
+	if err := s.uploadRepo.UpdateUploadOffset(uploadID, upload.Offset, upload.ExpiresAt); err != nil {
+		return nil, nil, nil, fmt.Errorf("업로드 정보를 갱신하는데 실패했습니다: %v", err)
+	}
+	if writeErr != nil {
+		return nil, nil, nil, fmt.Errorf("업로드한 데이터를 저장하는데 실패했습니다: %v", writeErr)
+	}
+	if upload.Offset < upload.Length {
+		return upload, nil, nil, nil
+	}
+
+	// 이미지 저장에 실패하면 업로드를 남겨둬서 빈 요청으로 다시 완료를 시도할 수 있도록 함
+	image, unknownCategories, err := s.completeUpload(upload)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return upload, image, unknownCategories, nil
+}
+
+// DeleteUpload 업로드 취소, 임시 파일과 업로드 정보 삭제
+func (s *uploadService) DeleteUpload(uploadID string, userID uint) error {
+	unlock := s.lock(uploadID)
+	defer unlock()
+
+	upload, err := s.uploadRepo.GetUploadByID(uploadID)
+	if err != nil || upload.UserID != userID {
+		return ErrUploadNotFound
+	}
+	return s.removeUpload(uploadID)
+}
+
+// PurgeExpiredUploads 만료된 업로드의 임시 파일과 업로드 정보 삭제, 삭제한 업로드 수 반환
+func (s *uploadService) PurgeExpiredUploads() (int, error) {
+	uploads, err := s.uploadRepo.GetExpiredUploads(time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("만료된 업로드를 가져오는데 실패했습니다: %v", err)
+	}
+
+	purged := 0
+	for _, upload := range uploads {
+		unlock := s.lock(upload.ID)
+		err := s.removeUpload(upload.ID)
+		unlock()
+		if err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// completeUpload 업로드가 끝난 임시 파일로 이미지를 저장하고 업로드 정보 삭제
+func (s *uploadService) completeUpload(upload *models.UploadSession) (*models.Image, []string, error) {
+	file, err := s.storage.Open(s.uploadPartPath(upload.ID))
+	if err != nil {
+		return nil, nil, fmt.Errorf("업로드 임시 파일을 여는데 실패했습니다: %v", err)
+	}
+	defer file.Close()
+
+	var categoryNames []string
+	if upload.Categories != "" {
+		categoryNames = strings.Split(upload.Categories, ",")
+	}
+	image, unknownCategories, err := s.imageService.UploadImageFromReader(file, upload.FileName, upload.Description, upload.UserID, categoryNames, upload.StrictCategories)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.removeUpload(upload.ID); err != nil {
+		fmt.Printf("완료된 업로드를 삭제하는데 실패했습니다: %v\n", err)
+	}
+	return image, unknownCategories, nil
+}
+
+func (s *uploadService) removeUpload(uploadID string) error {
+	if err := s.storage.Remove(s.uploadPartPath(uploadID)); err != nil {
+		return fmt.Errorf("업로드 임시 파일을 삭제하는데 실패했습니다: %v", err)
+	}
+	if err := s.uploadRepo.DeleteUpload(uploadID); err != nil {
+		return fmt.Errorf("업로드 정보를 삭제하는데 실패했습니다: %v", err)
+	}
+	s.locks.Delete(uploadID)
+	return nil
+}
+
+// lock 업로드 ID별 잠금, 반환한 함수로 잠금 해제
+func (s *uploadService) lock(uploadID string) func() {
+	value, _ := s.locks.LoadOrStore(uploadID, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+func (s *uploadService) uploadPartPath(uploadID string) string {
+	return filepath.Join(s.uploadsDir, uploadPartDir, uploadID)
+}
+
+func generateUploadID() (string, error) {
+	buf := make([]byte, uploadIDBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,98 @@
+package signedurl
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 업로드된 파일을 JWT 없이 <img> 태그 등에서 바로 조회할 수 있도록
+// 파일 경로와 만료 시각을 HMAC으로 서명한 URL을 만들고 검증한다.
+// 검증에는 DB 조회가 필요 없다.
+
+const (
+	PathPrefix = "/files" // 서명된 파일 URL 경로
+
+	ttl = time.Hour // 서명된 URL 유효 시간
+	// 만료 시각을 10분 단위로 올림해서 같은 파일은 10분 동안 같은 URL이 되도록 함 (브라우저 캐시 활용)
+	expiryBucket = 10 * time.Minute
+)
+
+var (
+	ErrInvalidSignature = errors.New("유효하지 않은 서명입니다")
+	ErrExpired          = errors.New("만료된 URL입니다")
+)
+
+// Signer 설정의 서명 키와 업로드 디렉토리로 파일 URL을 서명하고 검증
+type Signer struct {
+	key        []byte // 비어 있으면 URL을 서명하지 않음
+	uploadsDir string // 서명할 수 있는 파일이 있는 디렉토리
+}
+
+// NewSigner URL 서명 키와 업로드 디렉토리로 Signer 생성
+func NewSigner(key, uploadsDir string) *Signer {
+	return &Signer{key: []byte(key), uploadsDir: filepath.Clean(uploadsDir)}
+}
+
+// Sign 서버에 저장된 파일 경로를 서명된 URL로 변환, 서명 키가 없거나 업로드 디렉토리 밖의 경로는 빈 문자열 반환
+func (s *Signer) Sign(storagePath string) string {
+	expiresAt := time.Now().Add(ttl).Truncate(expiryBucket).Add(expiryBucket)
+	return s.SignWithExpiry(storagePath, expiresAt)
+}
+
+// SignWithExpiry 지정한 만료 시각으로 서명된 URL 생성
+func (s *Signer) SignWithExpiry(storagePath string, expiresAt time.Time) string {
+	if storagePath == "" || len(s.key) == 0 {
+		return ""
+	}
+	rel, err := filepath.Rel(s.uploadsDir, storagePath)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+
+	urlPath := path.Join(PathPrefix, filepath.ToSlash(rel))
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", s.signature(urlPath, expires))
+	return (&url.URL{Path: urlPath, RawQuery: query.Encode()}).String()
+}
+
+// Verify URL 경로, 만료 시각, 서명을 검증하고 만료 시각 반환
+func (s *Signer) Verify(urlPath, expires, sig string) (time.Time, error) {
+	if sig == "" || len(s.key) == 0 || !hmac.Equal([]byte(sig), []byte(s.signature(urlPath, expires))) {
+		return time.Time{}, ErrInvalidSignature
+	}
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return time.Time{}, ErrInvalidSignature
+	}
+	expiresAt := time.Unix(unix, 0)
+	if time.Now().After(expiresAt) {
+		return time.Time{}, ErrExpired
+	}
+	return expiresAt, nil
+}
+
+// StoragePath 서명된 URL 경로를 서버에 저장된 파일 경로로 변환
+func (s *Signer) StoragePath(urlPath string) (string, error) {
+	rel := strings.TrimPrefix(urlPath, PathPrefix+"/")
+	if rel == urlPath || rel == "" || path.Clean("/"+rel) != "/"+rel {
+		return "", ErrInvalidSignature
+	}
+	return filepath.Join(s.uploadsDir, filepath.FromSlash(rel)), nil
+}
+
+func (s *Signer) signature(urlPath, expires string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(urlPath + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// localStorage 서버 로컬 디스크 저장소
+type localStorage struct{}
+
+func NewLocalStorage() Storage {
+	return &localStorage{}
+}
+
+// Save 같은 디렉토리의 임시 파일에 모두 쓴 뒤 이름을 바꿔서, 쓰는 도중 실패하거나 서버가 종료돼도 path에 덜 쓰인 파일이 남지 않음
+func (s *localStorage) Save(path string, r io.Reader) (int64, error) {
+	tmpPath := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	n, err := s.write(tmpPath, r, os.O_CREATE|os.O_WRONLY|os.O_TRUNC)
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+	}
+	return n, err
+}
+
+func (s *localStorage) Append(path string, r io.Reader) (int64, error) {
+	return s.write(path, r, os.O_CREATE|os.O_WRONLY|os.O_APPEND)
+}
+
+func (s *localStorage) Open(path string) (io.ReadCloser, error) {
+	return os.Open(path)
+}
+
+func (s *localStorage) Size(path string) (int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	if info.IsDir() {
+		return 0, fmt.Errorf("%s: 파일이 아닙니다", path)
+	}
+	return info.Size(), nil
+}
+
+func (s *localStorage) Remove(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// write 디렉토리가 없으면 만들고 r의 내용을 파일에 쓰기, 중간에 실패해도 쓴 만큼의 크기 반환
+func (s *localStorage) write(path string, r io.Reader, flag int) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return 0, err
+	}
+	file, err := os.OpenFile(path, flag, 0o644)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(file, r)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return n, err
+}
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"github.com/zeze1004/image-hub-platform/metrics"
+	"io"
+	"time"
+)
+
+// metricsStorage 감싼 저장소의 작업 시간을 메트릭으로 기록하는 저장소
+type metricsStorage struct {
+	storage Storage
+}
+
+// WithMetrics s의 작업 시간을 기록하는 저장소 반환, Open은 파일을 여는 시간만 기록
+func WithMetrics(s Storage) Storage {
+	return &metricsStorage{storage: s}
+}
+
+func (s *metricsStorage) Save(path string, r io.Reader) (int64, error) {
+	start := time.Now()
+	n, err := s.storage.Save(path, r)
+	metrics.ObserveStorage("save", time.Since(start), err)
+	return n, err
+}
+
+func (s *metricsStorage) Append(path string, r io.Reader) (int64, error) {
+	start := time.Now()
+	n, err := s.storage.Append(path, r)
+	metrics.ObserveStorage("append", time.Since(start), err)
+	return n, err
+}
+
+func (s *metricsStorage) Open(path string) (io.ReadCloser, error) {
+	start := time.Now()
+	file, err := s.storage.Open(path)
+	metrics.ObserveStorage("open", time.Since(start), err)
+	return file, err
+}
+
+func (s *metricsStorage) Size(path string) (int64, error) {
+	start := time.Now()
+	size, err := s.storage.Size(path)
+	metrics.ObserveStorage("size", time.Since(start), err)
+	return size, err
+}
+
+func (s *metricsStorage) Remove(path string) error {
+	start := time.Now()
+	err := s.storage.Remove(path)
+	metrics.ObserveStorage("remove", time.Since(start), err)
+	return err
+}
@@ -0,0 +1,15 @@
+package storage
+
+import (
+	"io"
+)
+
+// Storage 원본, 썸네일, 업로드 중인 파일 등을 저장하는 저장소
+// 경로는 DB의 FilePath, ThumbnailPath에 저장되는 값을 그대로 사용
+type Storage interface {
+	Save(path string, r io.Reader) (int64, error)   // 파일 생성, 이미 있으면 덮어쓰기
+	Append(path string, r io.Reader) (int64, error) // 파일 끝에 이어쓰기, 없으면 생성
+	Open(path string) (io.ReadCloser, error)
+	Size(path string) (int64, error)
+	Remove(path string) error // 파일이 없어도 에러로 보지 않음
+}
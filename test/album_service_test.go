@@ -0,0 +1,78 @@
+package test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/services"
+	"testing"
+)
+
+// 앨범에 이미 담긴 이미지는 건너뛰고 소유한 이미지만 추가되는지 테스트
+func TestAddImagesToAlbum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockAlbumRepo := mocks.NewMockAlbumRepository(ctrl)
+
+	userID, albumID := uint(1), uint(10)
+	mockAlbumRepo.EXPECT().GetAlbumByID(albumID).Return(&models.Album{ID: albumID, UserID: userID}, nil)
+	mockAlbumRepo.EXPECT().GetAlbumImageIDs(albumID).Return([]uint{1}, nil)
+	mockImageRepo.EXPECT().GetImageByID(uint(2)).Return(&models.Image{ID: 2, UserID: userID}, nil)
+	mockImageRepo.EXPECT().GetImageByID(uint(3)).Return(&models.Image{ID: 3, UserID: userID}, nil)
+	mockAlbumRepo.EXPECT().AddImagesToAlbum(albumID, []uint{2, 3}).Return(nil)
+
+	albumService := services.NewAlbumService(mockImageRepo, mockAlbumRepo)
+
+	err := albumService.AddImagesToAlbum(albumID, userID, false, []uint{1, 2, 3, 2})
+
+	assert.NoError(t, err)
+}
+
+// 다른 사용자의 이미지나 앨범에는 접근할 수 없는지 테스트
+func TestAlbumOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockAlbumRepo := mocks.NewMockAlbumRepository(ctrl)
+
+	mockAlbumRepo.EXPECT().GetAlbumByID(uint(10)).Return(&models.Album{ID: 10, UserID: 1}, nil).Times(2)
+	mockAlbumRepo.EXPECT().GetAlbumImageIDs(uint(10)).Return(nil, nil)
+	mockImageRepo.EXPECT().GetImageByID(uint(5)).Return(&models.Image{ID: 5, UserID: 2}, nil)
+
+	albumService := services.NewAlbumService(mockImageRepo, mockAlbumRepo)
+
+	// 앨범 소유자가 아니면 앨범 삭제 불가
+	err := albumService.DeleteAlbum(10, 2, false)
+	assert.ErrorContains(t, err, "앨범에 대한 권한이 없습니다")
+
+	// 관리자라도 앨범 소유자의 이미지가 아니면 추가 불가
+	err = albumService.AddImagesToAlbum(10, 99, true, []uint{5})
+	assert.ErrorContains(t, err, "이미지에 대한 권한이 없습니다")
+}
+
+// 순서 변경 요청이 앨범의 모든 이미지를 중복 없이 포함해야 하는지 테스트
+func TestReorderAlbumImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockAlbumRepo := mocks.NewMockAlbumRepository(ctrl)
+
+	mockAlbumRepo.EXPECT().GetAlbumByID(uint(10)).Return(&models.Album{ID: 10, UserID: 1}, nil).AnyTimes()
+	mockAlbumRepo.EXPECT().GetAlbumImageIDs(uint(10)).Return([]uint{1, 2, 3}, nil).AnyTimes()
+	mockAlbumRepo.EXPECT().ReorderAlbumImages(uint(10), []uint{3, 1, 2}).Return(nil)
+
+	albumService := services.NewAlbumService(mockImageRepo, mockAlbumRepo)
+
+	assert.NoError(t, albumService.ReorderAlbumImages(10, 1, false, []uint{3, 1, 2}))
+
+	for _, imageIDs := range [][]uint{{1, 2}, {1, 2, 2}, {1, 2, 4}} {
+		err := albumService.ReorderAlbumImages(10, 1, false, imageIDs)
+		assert.True(t, errors.Is(err, services.ErrInvalidAlbumRequest), "순서 %v", imageIDs)
+	}
+}
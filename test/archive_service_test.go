@@ -0,0 +1,144 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// 날짜 조건으로 이미지를 고르고, 원본과 manifest.json을 담은 ZIP을 만드는지 테스트
+func TestArchiveImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "cat.jpg")
+	assert.NoError(t, os.WriteFile(filePath, []byte("cat image"), 0o644))
+
+	day := time.Date(2024, 1, 10, 12, 0, 0, 0, time.Local)
+	images := []models.Image{
+		{ID: 1, FileName: "cat.jpg", FilePath: filePath, UserID: 1, UploadDate: day},
+		{ID: 2, FileName: "missing.jpg", FilePath: filepath.Join(dir, "missing.jpg"), UserID: 1, UploadDate: day},
+	}
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	// 날짜 조건과 최대 개수보다 한 개 많은 제한을 쿼리에 넘김
+	mockImageRepo.EXPECT().GetArchiveImages(uint(1), uint(0), &from, nil, 5001).Return(images, nil)
+	mockCategoryRepo.EXPECT().GetCategoryNamesByImageIDs([]uint{1, 2}).Return([]models.ImageCategoryName{
+		{ImageID: 1, CategoryID: 3, Name: "ANIMAL"},
+	}, nil)
+
+	archiveService := services.NewArchiveService(mockImageRepo, mockCategoryRepo, mocks.NewMockAlbumRepository(ctrl), mockPermissionRepo, storage.NewLocalStorage())
+
+	selected, err := archiveService.GetArchiveImages(services.ArchiveFilter{From: &from}, 1, false)
+	assert.NoError(t, err)
+	assert.Len(t, selected, 2)
+
+	var buf bytes.Buffer
+	assert.NoError(t, archiveService.WriteArchive(&buf, selected, services.ArchiveOptions{}))
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	files := make(map[string]*zip.File)
+	for _, file := range reader.File {
+		files[file.Name] = file
+	}
+	assert.Len(t, files, 2)
+
+	image, err := files["images/1_cat.jpg"].Open()
+	assert.NoError(t, err)
+	content, _ := io.ReadAll(image)
+	assert.Equal(t, "cat image", string(content))
+
+	manifestFile, err := files["manifest.json"].Open()
+	assert.NoError(t, err)
+	var manifest models.ArchiveManifest
+	assert.NoError(t, json.NewDecoder(manifestFile).Decode(&manifest))
+	assert.Equal(t, 2, manifest.ImageCount)
+	assert.Equal(t, []string{"ANIMAL"}, manifest.Images[0].Categories)
+	assert.Equal(t, "images/1_cat.jpg", manifest.Images[0].Path)
+	assert.NotEmpty(t, manifest.Images[1].Error) // 원본 파일이 없는 이미지는 사유만 기록
+}
+
+// 권한이 없는 이미지가 포함되면 압축하기 전에 거절하는지 테스트
+func TestArchiveImagesForbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+	mockImageRepo.EXPECT().GetImagesByIDs([]uint{5, 6}).Return([]models.Image{{ID: 6, UserID: 2}, {ID: 5, UserID: 2}}, nil)
+	mockPermissionRepo.EXPECT().GetPermissionsByImageIDsAndUserID([]uint{5, 6}, uint(1)).
+		Return([]models.ImagePermission{{ImageID: 5, UserID: 1, Role: models.ImageRoleViewer}}, nil)
+
+	archiveService := services.NewArchiveService(mockImageRepo, mocks.NewMockCategoryRepository(ctrl), mocks.NewMockAlbumRepository(ctrl), mockPermissionRepo, storage.NewLocalStorage())
+
+	_, err := archiveService.GetArchiveImages(services.ArchiveFilter{ImageIDs: []uint{5, 6}}, 1, false)
+	assert.ErrorIs(t, err, utils.ErrImagePermissionDenied)
+	assert.Contains(t, err.Error(), "이미지 6")
+}
+
+// 선택한 이미지를 한 번에 조회하고 권한도 한 번에 확인해서 요청한 순서대로 반환하는지 테스트
+func TestArchiveImagesByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+	mockImageRepo.EXPECT().GetImagesByIDs([]uint{3, 1, 2}).
+		Return([]models.Image{{ID: 1, UserID: 1}, {ID: 2, UserID: 2}, {ID: 3, UserID: 1}}, nil)
+	// 소유한 이미지는 권한을 조회하지 않음
+	mockPermissionRepo.EXPECT().GetPermissionsByImageIDsAndUserID([]uint{2}, uint(1)).
+		Return([]models.ImagePermission{{ImageID: 2, UserID: 1, Role: models.ImageRoleViewer}}, nil)
+
+	archiveService := services.NewArchiveService(mockImageRepo, mocks.NewMockCategoryRepository(ctrl), mocks.NewMockAlbumRepository(ctrl), mockPermissionRepo, storage.NewLocalStorage())
+
+	selected, err := archiveService.GetArchiveImages(services.ArchiveFilter{ImageIDs: []uint{3, 1, 3, 2}}, 1, false)
+	assert.NoError(t, err)
+	if assert.Len(t, selected, 3) {
+		assert.Equal(t, []uint{3, 1, 2}, []uint{selected[0].ID, selected[1].ID, selected[2].ID})
+	}
+
+	// 없는 이미지가 있으면 ErrImageNotFound 반환
+	mockImageRepo.EXPECT().GetImagesByIDs([]uint{1, 9}).Return([]models.Image{{ID: 1, UserID: 1}}, nil)
+	_, err = archiveService.GetArchiveImages(services.ArchiveFilter{ImageIDs: []uint{1, 9}}, 1, false)
+	assert.ErrorIs(t, err, utils.ErrImageNotFound)
+}
+
+// 앨범을 압축할 때 소유자를 검증하고 앨범 순서를 유지하는지 테스트
+func TestArchiveAlbumImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAlbumRepo := mocks.NewMockAlbumRepository(ctrl)
+	mockAlbumRepo.EXPECT().GetAlbumByID(uint(7)).Return(&models.Album{ID: 7, UserID: 1}, nil).Times(2)
+	mockAlbumRepo.EXPECT().GetAlbumImages(uint(7), 0).Return([]models.Image{{ID: 4, UserID: 1}, {ID: 2, UserID: 1}}, nil)
+
+	archiveService := services.NewArchiveService(mocks.NewMockImageRepository(ctrl), mocks.NewMockCategoryRepository(ctrl), mockAlbumRepo, mocks.NewMockPermissionRepository(ctrl), storage.NewLocalStorage())
+
+	selected, err := archiveService.GetArchiveImages(services.ArchiveFilter{AlbumID: 7}, 1, false)
+	assert.NoError(t, err)
+	if assert.Len(t, selected, 2) {
+		assert.Equal(t, []uint{4, 2}, []uint{selected[0].ID, selected[1].ID})
+	}
+
+	// 다른 사용자의 앨범은 압축할 수 없음
+	_, err = archiveService.GetArchiveImages(services.ArchiveFilter{AlbumID: 7}, 2, false)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "앨범에 대한 권한이 없습니다")
+}
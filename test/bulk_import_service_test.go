@@ -0,0 +1,139 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"image"
+	"image/jpeg"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// 메타데이터 파일에 따라 이미지를 가져오고, 이미 가져온 파일은 건너뛰며, 잘못된 항목은 항목별로 실패하는지 테스트
+func TestBulkImportImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer os.RemoveAll("./uploads")
+
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	encodeJPEG := func(size int) []byte {
+		var buf bytes.Buffer
+		assert.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, size, size)), nil))
+		return buf.Bytes()
+	}
+	cat, dog := encodeJPEG(100), encodeJPEG(120)
+	dogHash := sha256.Sum256(dog)
+
+	fsys := fstest.MapFS{
+		"metadata.csv": {Data: []byte("file,owner_email,description,categories,capture_date\n" +
+			"photos/cat.jpg,a@example.com,고양이,animal;nope,2019-05-01\n" +
+			"photos/dog.jpg,a@example.com,,,\n" +
+			"other/cat.jpg,a@example.com,,,\n" +
+			"photos/cat.jpg,b@example.com,,,\n" +
+			"../secret.jpg,a@example.com,,,\n")},
+		"photos/cat.jpg": {Data: cat},
+		"photos/dog.jpg": {Data: dog},
+		"other/cat.jpg":  {Data: cat},
+	}
+
+	mockUserRepo.EXPECT().GetUserByEmail("a@example.com").Return(&models.User{ID: 1, Email: "a@example.com"}, nil)
+	mockUserRepo.EXPECT().GetUserByEmail("b@example.com").Return(nil, errors.New("record not found"))
+	var createdMu sync.Mutex
+	var createdHash string
+	mockImageRepo.EXPECT().FindImageByUserIDAndContentHash(uint(1), gomock.Any()).DoAndReturn(func(userID uint, contentHash string) (*models.Image, error) {
+		// 개는 이전 실행에서 이미 가져온 이미지
+		if contentHash == hex.EncodeToString(dogHash[:]) {
+			return &models.Image{ID: 5, UserID: 1}, nil
+		}
+		// 이름이 같아도 다른 경로의 같은 내용 파일은 먼저 가져온 이미지로 건너뜀
+		createdMu.Lock()
+		defer createdMu.Unlock()
+		if contentHash == createdHash {
+			return &models.Image{ID: 10, UserID: 1, CapturedAt: &time.Time{}}, nil
+		}
+		return nil, nil
+	}).Times(3)
+	mockCategoryRepo.EXPECT().GetCategoriesByName([]string{"ANIMAL", "NOPE"}).Return([]models.Category{{ID: 3, Name: "ANIMAL"}}, nil)
+	mockCategoryRepo.EXPECT().GetCategoryAliases([]string{"NOPE"}).Return(nil, nil)
+	mockImageRepo.EXPECT().CreateImage(gomock.Any(), []uint{3}).DoAndReturn(func(image *models.Image, categoryIDs []uint) error {
+		image.ID = 10
+		createdMu.Lock()
+		createdHash = image.ContentHash
+		createdMu.Unlock()
+		return nil
+	})
+	mockImageRepo.EXPECT().UpdateImageCapturedAt(uint(10), time.Date(2019, 5, 1, 0, 0, 0, 0, time.Local)).Return(nil)
+
+	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo, storage.NewLocalStorage(), testConfig.Storage, testConfig.Image, nil)
+	bulkImportService := services.NewBulkImportService(mockUserRepo, mockImageRepo, imageService)
+
+	items, err := bulkImportService.LoadSidecar(fsys)
+	assert.NoError(t, err)
+	assert.Len(t, items, 5)
+	assert.Equal(t, 2, items[0].Line)
+
+	var reported int
+	results := bulkImportService.ImportImages(context.Background(), fsys, items, 2, func(services.BulkImportResult) {
+		reported++
+	})
+	assert.Len(t, results, 5)
+	assert.Equal(t, 5, reported)
+
+	assert.Equal(t, services.BulkImportStatusImported, results[0].Status, results[0].Error)
+	assert.Equal(t, uint(10), results[0].ImageID)
+	assert.Equal(t, []string{"nope"}, results[0].InvalidCategories)
+
+	assert.Equal(t, services.BulkImportStatusSkipped, results[1].Status)
+	assert.Equal(t, uint(5), results[1].ImageID)
+
+	assert.Equal(t, services.BulkImportStatusSkipped, results[2].Status, results[2].Error)
+	assert.Equal(t, uint(10), results[2].ImageID)
+
+	for _, result := range results[3:] {
+		assert.Equal(t, services.BulkImportStatusFailed, result.Status, result.File)
+		assert.NotEmpty(t, result.Error)
+	}
+	assert.Contains(t, results[3].Error, "b@example.com")
+}
+
+// JSON 메타데이터 파일을 파싱하고, 필수 값이나 날짜 형식이 잘못되면 거절하는지 테스트
+func TestParseBulkImportSidecar(t *testing.T) {
+	bulkImportService := services.NewBulkImportService(nil, nil, nil)
+
+	items, err := bulkImportService.ParseSidecar(strings.NewReader(`[
+		{"file": "a.jpg", "owner_email": "a@example.com", "categories": ["FOOD"], "capture_date": "2020-01-02T03:04:05Z"},
+		{"file": "b.jpg", "owner_email": "a@example.com"}
+	]`), "metadata.json")
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, []string{"FOOD"}, items[0].Categories)
+	assert.True(t, items[0].CapturedAt.Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)))
+	assert.Nil(t, items[1].CapturedAt)
+
+	_, err = bulkImportService.ParseSidecar(strings.NewReader(`[{"file": "a.jpg"}]`), "metadata.json")
+	assert.ErrorIs(t, err, services.ErrInvalidBulkImportSidecar)
+
+	_, err = bulkImportService.ParseSidecar(strings.NewReader("file,owner_email,capture_date\na.jpg,a@example.com,01/02/2020\n"), "metadata.csv")
+	assert.ErrorIs(t, err, services.ErrInvalidBulkImportSidecar)
+
+	_, err = bulkImportService.ParseSidecar(strings.NewReader(""), "metadata.txt")
+	assert.ErrorIs(t, err, services.ErrInvalidBulkImportSidecar)
+}
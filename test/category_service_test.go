@@ -0,0 +1,166 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+// 권한이 있는 이미지만 일괄 변경되고, 나머지는 이미지별 실패 결과로 반환되는지 테스트
+func TestBulkUpdateImageCategories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	userID := uint(1)
+	mockImageRepo.EXPECT().GetImageByID(uint(1)).Return(&models.Image{ID: 1, UserID: userID}, nil)
+	mockImageRepo.EXPECT().GetImageByID(uint(2)).Return(&models.Image{ID: 2, UserID: 2}, nil)
+	mockImageRepo.EXPECT().GetImageByID(uint(3)).Return(nil, gorm.ErrRecordNotFound)
+	mockPermissionRepo.EXPECT().GetPermissionRole(uint(2), userID).Return("", nil)
+
+	mockImageCategoryRepo.EXPECT().Transaction(gomock.Any()).DoAndReturn(
+		func(fn func(txRepo repositories.ImageCategoryRepository) error) error {
+			return fn(mockImageCategoryRepo)
+		}).Times(2)
+	// 이미 등록된 카테고리(1)는 건너뛰고 새 카테고리(2)만 추가
+	mockImageCategoryRepo.EXPECT().LockImage(uint(1)).Return(nil)
+	mockImageCategoryRepo.EXPECT().GetCategoriesByImageID(uint(1)).Return([]models.Category{{ID: 1}}, nil)
+	mockImageCategoryRepo.EXPECT().AddCategoryToImage(uint(1), uint(2)).Return(nil)
+
+	categoryService := services.NewCategoryService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo)
+
+	results, err := categoryService.BulkUpdateImageCategories(services.BulkCategoryAdd, []uint{1, 2, 3, 1}, []uint{1, 2}, userID, false)
+
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+	assert.True(t, results[0].Success)
+	assert.False(t, results[1].Success)
+	assert.Contains(t, results[1].Error, "권한이 없습니다")
+	assert.False(t, results[2].Success)
+	assert.Contains(t, results[2].Error, "찾을 수 없습니다")
+}
+
+// 이미지 하나의 변경이 실패하면 그 이미지만 되돌리고 결과에 실패로 기록하며, 나머지 이미지는 반영하는지 테스트
+func TestBulkUpdateImageCategoriesPartialFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	mockImageRepo.EXPECT().GetImageByID(gomock.Any()).Return(&models.Image{}, nil).Times(2)
+	// 전체 트랜잭션 하나와 이미지마다 세이브포인트 하나
+	mockImageCategoryRepo.EXPECT().Transaction(gomock.Any()).DoAndReturn(
+		func(fn func(txRepo repositories.ImageCategoryRepository) error) error {
+			return fn(mockImageCategoryRepo)
+		}).Times(3)
+	mockImageCategoryRepo.EXPECT().LockImage(gomock.Any()).Return(nil).Times(2)
+	mockImageCategoryRepo.EXPECT().RemoveAllCategoriesFromImage(uint(1)).Return(nil)
+	mockImageCategoryRepo.EXPECT().GetCategoriesByImageID(uint(1)).Return(nil, nil)
+	mockImageCategoryRepo.EXPECT().AddCategoryToImage(uint(1), uint(3)).Return(nil)
+	mockImageCategoryRepo.EXPECT().RemoveAllCategoriesFromImage(uint(2)).Return(fmt.Errorf("deadlock"))
+
+	categoryService := services.NewCategoryService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo)
+
+	results, err := categoryService.BulkUpdateImageCategories(services.BulkCategorySet, []uint{1, 2}, []uint{3}, 0, true)
+
+	assert.NoError(t, err)
+	if assert.Len(t, results, 2) {
+		assert.True(t, results[0].Success)
+		assert.False(t, results[1].Success)
+		assert.Contains(t, results[1].Error, "deadlock")
+	}
+}
+
+// 잘못된 작업이나 카테고리 ID는 ErrInvalidBulkRequest 반환 테스트
+func TestBulkUpdateImageCategoriesInvalidRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	categoryService := services.NewCategoryService(
+		mocks.NewMockImageRepository(ctrl), mocks.NewMockCategoryRepository(ctrl), mocks.NewMockImageCategoryRepository(ctrl),
+		mocks.NewMockPermissionRepository(ctrl),
+	)
+
+	_, err := categoryService.BulkUpdateImageCategories("move", []uint{1}, []uint{1}, 1, false)
+	assert.True(t, errors.Is(err, services.ErrInvalidBulkRequest))
+
+	_, err = categoryService.BulkUpdateImageCategories(services.BulkCategoryAdd, []uint{1}, []uint{9}, 1, false)
+	assert.True(t, errors.Is(err, services.ErrInvalidBulkRequest))
+
+	_, err = categoryService.BulkUpdateImageCategories(services.BulkCategoryRemove, []uint{1}, nil, 1, false)
+	assert.True(t, errors.Is(err, services.ErrInvalidBulkRequest))
+}
+
+// 이미지당 최대 카테고리 수를 넘으면 ErrTooManyCategories 반환하고, 이미 붙은 카테고리는 최대 개수여도 그대로 성공하는지 테스트
+func TestAddCategoryToImageLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	full := []models.Category{{ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}, {ID: 6}}
+	mockImageRepo.EXPECT().GetImageByID(uint(1)).Return(&models.Image{ID: 1, UserID: 1}, nil).Times(2)
+	mockImageCategoryRepo.EXPECT().Transaction(gomock.Any()).DoAndReturn(
+		func(fn func(txRepo repositories.ImageCategoryRepository) error) error {
+			return fn(mockImageCategoryRepo)
+		}).Times(2)
+	mockImageCategoryRepo.EXPECT().LockImage(uint(1)).Return(nil).Times(2)
+	mockImageCategoryRepo.EXPECT().GetCategoriesByImageID(uint(1)).Return(full, nil).Times(2)
+
+	categoryService := services.NewCategoryService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo)
+
+	err := categoryService.AddCategoryToImageByImageIDAndCategoryID(1, 1, 1, false)
+	assert.True(t, errors.Is(err, services.ErrTooManyCategories))
+
+	err = categoryService.AddCategoryToImageByImageIDAndCategoryID(1, 2, 1, false)
+	assert.NoError(t, err)
+}
+
+// 카테고리 통계 조회 시 기간 검증과 집계 결과 반환 테스트
+func TestGetCategoryStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 0, 7)
+	mockCategoryRepo.EXPECT().CountImagesByCategory(uint(0)).Return([]models.CategoryImageCount{{CategoryID: 1, Name: "PERSON", ImageCount: 2}}, nil)
+	mockCategoryRepo.EXPECT().CountImagesByUserAndCategory(uint(0)).Return([]models.UserCategoryImageCount{{UserID: 1, CategoryID: 1, Name: "PERSON", ImageCount: 2}}, nil)
+	mockCategoryRepo.EXPECT().CountDailyUploadsByCategory(from, to, uint(0)).Return([]models.DailyCategoryUpload{
+		{Date: "2024-01-02", CategoryID: 1, Name: "PERSON", ImageCount: 1},
+		{Date: "2024-01-03", CategoryID: 1, Name: "PERSON", ImageCount: 1},
+	}, nil)
+
+	categoryService := services.NewCategoryService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo)
+
+	stats, err := categoryService.GetCategoryStats(0, from, to)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-02", stats.DailyUploads[0].Date)
+	assert.Equal(t, "2024-01-03", stats.DailyUploads[1].Date)
+
+	_, err = categoryService.GetCategoryStats(0, to, from)
+	assert.True(t, errors.Is(err, services.ErrInvalidStatsPeriod))
+}
@@ -0,0 +1,161 @@
+package test
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeExportNotifier struct {
+	finished chan models.ExportJob
+}
+
+func (n *fakeExportNotifier) NotifyExportFinished(user *models.User, job *models.ExportJob) error {
+	n.finished <- *job
+	return nil
+}
+
+// 내보내기를 요청하면 백그라운드 작업자가 프로필, 원본, 썸네일, manifest.json을 압축해서 저장하고 알리는지 테스트
+func TestExportUserData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExportRepo := mocks.NewMockExportRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "cat.jpg")
+	thumbnailPath := filepath.Join(dir, "thumb_cat.jpg")
+	assert.NoError(t, os.WriteFile(filePath, []byte("cat image"), 0o644))
+	assert.NoError(t, os.WriteFile(thumbnailPath, []byte("cat thumbnail"), 0o644))
+
+	// 작업자 고루틴과 테스트가 같은 작업을 보므로 잠금으로 보호
+	var mu sync.Mutex
+	var stored models.ExportJob
+	pendingFetched := false
+
+	mockExportRepo.EXPECT().CreateExportJobIfIdle(gomock.Any()).DoAndReturn(func(job *models.ExportJob) (*models.ExportJob, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		job.ID = 7
+		stored = *job
+		return nil, nil
+	})
+	mockExportRepo.EXPECT().GetExportJobsByStatus(models.ExportStatusRunning).Return(nil, nil)
+	mockExportRepo.EXPECT().GetExportJobsByStatus(models.ExportStatusPending).DoAndReturn(func(string) ([]models.ExportJob, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if pendingFetched || stored.ID == 0 {
+			return nil, nil
+		}
+		pendingFetched = true
+		return []models.ExportJob{stored}, nil
+	}).AnyTimes()
+	mockExportRepo.EXPECT().UpdateExportJob(gomock.Any()).DoAndReturn(func(job *models.ExportJob) error {
+		mu.Lock()
+		defer mu.Unlock()
+		stored = *job
+		return nil
+	}).AnyTimes()
+	mockExportRepo.EXPECT().GetExportJobByID(uint(7)).DoAndReturn(func(uint) (*models.ExportJob, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		job := stored
+		return &job, nil
+	})
+
+	mockUserRepo.EXPECT().GetUserByID(uint(1)).Return(&models.User{ID: 1, Email: "user@example.com", Password: "hashed", Role: "USER"}, nil)
+	mockImageRepo.EXPECT().GetImagesByUserID(uint(1)).Return([]models.Image{
+		{ID: 1, FileName: "cat.jpg", FilePath: filePath, ThumbnailPath: thumbnailPath, UserID: 1},
+	}, nil)
+	mockCategoryRepo.EXPECT().GetCategoryNamesByImageIDs([]uint{1}).Return([]models.ImageCategoryName{
+		{ImageID: 1, CategoryID: 3, Name: "ANIMAL"},
+	}, nil)
+
+	fileStorage := storage.NewLocalStorage()
+	archiveService := services.NewArchiveService(mockImageRepo, mockCategoryRepo, mocks.NewMockAlbumRepository(ctrl), mockPermissionRepo, fileStorage)
+	notifier := &fakeExportNotifier{finished: make(chan models.ExportJob, 1)}
+	exportService := services.NewExportService(mockExportRepo, mockUserRepo, mockImageRepo, archiveService, fileStorage, testSigner, notifier, testConfig.Storage)
+
+	job, err := exportService.RequestExport(1, true)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ExportStatusPending, job.Status)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go exportService.Run(ctx)
+
+	select {
+	case finished := <-notifier.finished:
+		assert.Equal(t, models.ExportStatusCompleted, finished.Status, finished.Error)
+		assert.Equal(t, 1, finished.ImageCount)
+	case <-time.After(5 * time.Second):
+		t.Fatal("내보내기 작업이 끝나지 않았습니다")
+	}
+	cancel()
+
+	job, err = exportService.GetExportJob(7, 1)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(job.DownloadURL, "/files/.exports/1/image-hub-export-7.zip?"))
+	assert.NotNil(t, job.ExpiresAt)
+	defer os.Remove(job.FilePath)
+
+	reader, err := zip.OpenReader(job.FilePath)
+	assert.NoError(t, err)
+	defer reader.Close()
+	files := make(map[string]*zip.File)
+	for _, file := range reader.File {
+		files[file.Name] = file
+	}
+	assert.Contains(t, files, "images/1_cat.jpg")
+	assert.Contains(t, files, "thumbnails/1_thumb_cat.jpg")
+
+	profileFile, err := files["profile.json"].Open()
+	assert.NoError(t, err)
+	var profile map[string]interface{}
+	assert.NoError(t, json.NewDecoder(profileFile).Decode(&profile))
+	profileFile.Close()
+	assert.Equal(t, "user@example.com", profile["email"])
+	assert.NotContains(t, profile, "password")
+
+	manifestFile, err := files["manifest.json"].Open()
+	assert.NoError(t, err)
+	var manifest models.ArchiveManifest
+	assert.NoError(t, json.NewDecoder(manifestFile).Decode(&manifest))
+	manifestFile.Close()
+	assert.Equal(t, []string{"ANIMAL"}, manifest.Images[0].Categories)
+	assert.Equal(t, "thumbnails/1_thumb_cat.jpg", manifest.Images[0].ThumbnailPath)
+
+	// 다른 사용자의 작업은 조회할 수 없음
+	mockExportRepo.EXPECT().GetExportJobByID(uint(7)).Return(&models.ExportJob{ID: 7, UserID: 1}, nil)
+	_, err = exportService.GetExportJob(7, 2)
+	assert.ErrorIs(t, err, services.ErrExportNotFound)
+}
+
+// 진행 중인 내보내기 작업이 있으면 새로 요청할 수 없는지 테스트
+func TestRequestExportInProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExportRepo := mocks.NewMockExportRepository(ctrl)
+	mockExportRepo.EXPECT().CreateExportJobIfIdle(gomock.Any()).Return(&models.ExportJob{ID: 3, UserID: 1, Status: models.ExportStatusRunning}, nil)
+
+	exportService := services.NewExportService(mockExportRepo, nil, nil, nil, storage.NewLocalStorage(), testSigner, services.NewLogExportNotifier(), testConfig.Storage)
+	_, err := exportService.RequestExport(1, false)
+	assert.ErrorIs(t, err, services.ErrExportInProgress)
+}
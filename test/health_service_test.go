@@ -0,0 +1,66 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/migrations"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// DB 연결, 저장소, 마이그레이션 중 하나라도 실패하면 준비되지 않은 상태로 판단하는지 테스트
+func TestHealthServiceReadiness(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHealthRepo := mocks.NewMockHealthRepository(ctrl)
+	uploadsDir := t.TempDir()
+	healthService := services.NewHealthService(mockHealthRepo, storage.NewLocalStorage(), config.StorageConfig{UploadsDir: uploadsDir}, nil)
+
+	mockHealthRepo.EXPECT().Ping(gomock.Any()).Return(nil)
+	mockHealthRepo.EXPECT().GetPendingMigrations(gomock.Any()).Return(nil, nil)
+	readiness := healthService.Readiness(context.Background())
+	assert.True(t, readiness.Ready)
+
+	// 저장소를 확인하고 남긴 파일이 없어야 함
+	entries, err := os.ReadDir(uploadsDir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	mockHealthRepo.EXPECT().Ping(gomock.Any()).Return(errors.New("connection refused"))
+	mockHealthRepo.EXPECT().GetPendingMigrations(gomock.Any()).Return([]migrations.Migration{{Version: 2, Name: "legacy_schema"}}, nil)
+	readiness = healthService.Readiness(context.Background())
+	assert.False(t, readiness.Ready)
+	assert.Equal(t, []services.ReadinessCheck{
+		{Name: services.ReadinessCheckDatabase, Error: "connection refused"},
+		{Name: services.ReadinessCheckStorage, OK: true},
+		{Name: services.ReadinessCheckMigrations, Error: "적용하지 않은 마이그레이션이 있습니다: 0002_legacy_schema"},
+	}, readiness.Checks)
+}
+
+// 업로드 디렉토리에 쓸 수 없으면 저장소 확인이 실패하는지 테스트
+func TestHealthServiceReadinessStorageFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHealthRepo := mocks.NewMockHealthRepository(ctrl)
+	mockHealthRepo.EXPECT().Ping(gomock.Any()).Return(nil)
+	mockHealthRepo.EXPECT().GetPendingMigrations(gomock.Any()).Return(nil, nil)
+
+	// 업로드 디렉토리 자리에 파일이 있어서 디렉토리를 만들 수 없는 경우
+	blocked := filepath.Join(t.TempDir(), "uploads")
+	assert.NoError(t, os.WriteFile(blocked, nil, 0o644))
+	healthService := services.NewHealthService(mockHealthRepo, storage.NewLocalStorage(), config.StorageConfig{UploadsDir: blocked}, nil)
+
+	readiness := healthService.Readiness(context.Background())
+	assert.False(t, readiness.Ready)
+	assert.False(t, readiness.Checks[1].OK)
+	assert.Contains(t, readiness.Checks[1].Error, "저장소에 쓰는데 실패했습니다")
+}
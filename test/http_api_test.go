@@ -0,0 +1,760 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/version"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 상태 확인 API는 인증 없이 접근하고, 종료가 시작되면 준비 상태 확인이 실패하는지 테스트
+func TestHTTPHealth(t *testing.T) {
+	server := newTestServer(t)
+
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, "/healthz", "", nil, ""))
+
+	w := server.do(http.MethodGet, "/readyz", "", nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var readiness services.Readiness
+	decodeJSON(t, w, &readiness)
+	assert.True(t, readiness.Ready)
+	assert.Len(t, readiness.Checks, 3)
+
+	w = server.do(http.MethodGet, "/version", "", nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var info version.Info
+	decodeJSON(t, w, &info)
+	assert.NotEmpty(t, info.Commit)
+	assert.NotEmpty(t, info.GoVersion)
+
+	server.background.Stop()
+	w = server.do(http.MethodGet, "/readyz", "", nil, "")
+	assertStatus(t, http.StatusServiceUnavailable, w)
+	decodeJSON(t, w, &readiness)
+	assert.False(t, readiness.Ready)
+	assert.Equal(t, services.ReadinessCheckShutdown, readiness.Checks[0].Name)
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, "/healthz", "", nil, ""))
+}
+
+// 회원가입, 로그인과 JWT가 없거나 권한이 맞지 않는 요청을 거절하는지 테스트
+func TestHTTPAuth(t *testing.T) {
+	server := newTestServer(t)
+
+	_, userToken := server.signUp("user@example.com", "user-password")
+	assertStatus(t, http.StatusUnauthorized, server.doJSON(http.MethodPost, "/auth/login", "", gin.H{"email": "user@example.com", "password": "wrong"}))
+	assertStatus(t, http.StatusBadRequest, server.doJSON(http.MethodPost, "/auth/signup", "", gin.H{"email": "user@example.com"}))
+
+	assertStatus(t, http.StatusUnauthorized, server.do(http.MethodGet, "/api/user/images", "", nil, ""))
+	assertStatus(t, http.StatusUnauthorized, server.do(http.MethodGet, "/api/user/images", "invalid-token", nil, ""))
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, "/api/user/images", userToken, nil, ""))
+
+	// 사용자는 관리자 API를, 관리자는 사용자 API를 쓸 수 없음
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, "/api/admin/images", userToken, nil, ""))
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, "/api/user/images", server.adminToken(), nil, ""))
+}
+
+// 한 프로세스에서 서명 키가 다른 서버를 함께 띄워도 각자 설정한 키로 토큰과 파일 URL을 검증하는지 테스트
+func TestHTTPServersUseOwnSigningKeys(t *testing.T) {
+	server := newTestServer(t)
+	other := newTestServer(t, func(cfg *config.Config) {
+		cfg.Auth.JWTSecret = "other-jwt-secret"
+		cfg.Auth.URLSigningKey = "other-url-signing-key"
+	})
+	_, token := server.signUp("user@example.com", "user-password")
+	_, otherToken := other.signUp("user@example.com", "user-password")
+	cat := server.uploadImage("/api/user/images", token, "cat.jpg")
+
+	var image models.Image
+	w := server.do(http.MethodGet, imagePath("/api/user/images", cat.ID, "/"), token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	decodeJSON(t, w, &image)
+
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, image.URL, "", nil, ""))
+	assertStatus(t, http.StatusForbidden, other.do(http.MethodGet, image.URL, "", nil, ""))
+	assertStatus(t, http.StatusUnauthorized, other.do(http.MethodGet, "/api/user/images", token, nil, ""))
+	assertStatus(t, http.StatusUnauthorized, server.do(http.MethodGet, "/api/user/images", otherToken, nil, ""))
+}
+
+// 업로드한 이미지를 조회하고, 원본과 썸네일, 서명된 파일 URL을 내려받고, 삭제하는지 테스트
+func TestHTTPUserImages(t *testing.T) {
+	server := newTestServer(t)
+	_, token := server.signUp("user@example.com", "user-password")
+	_, otherToken := server.signUp("other@example.com", "other-password")
+
+	uploaded := server.uploadImage("/api/user/images", token, "cat.jpg", "animal", "pets")
+	assert.NotZero(t, uploaded.ID)
+	assert.Equal(t, "cat.jpg", uploaded.FileName)
+
+	w := server.do(http.MethodGet, "/api/user/images", token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var images []models.Image
+	decodeJSON(t, w, &images)
+	assert.Len(t, images, 1)
+
+	w = server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/"), token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var image models.Image
+	decodeJSON(t, w, &image)
+	assert.True(t, strings.HasPrefix(image.URL, "/files/"))
+
+	// 카테고리 별칭(PETS)은 같은 카테고리로 합쳐짐
+	w = server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/categories"), token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var categories []models.Category
+	decodeJSON(t, w, &categories)
+	assert.Len(t, categories, 1)
+	assert.Equal(t, "ANIMAL", categories[0].Name)
+
+	w = server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/file"), token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	assert.Equal(t, "image/jpeg", w.Header().Get("Content-Type"))
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, imagePath("/api/user/images/thumbnail", uploaded.ID, "/"), token, nil, ""))
+	w = server.do(http.MethodGet, image.URL, "", nil, "")
+	assertStatus(t, http.StatusOK, w)
+	assert.NotEmpty(t, w.Header().Get("Content-Length"))
+	assert.Contains(t, w.Header().Get("Cache-Control"), "max-age=")
+	req := httptest.NewRequest(http.MethodGet, image.URL, nil)
+	req.Header.Set("Range", "bytes=0-1")
+	w = httptest.NewRecorder()
+	server.handler.ServeHTTP(w, req)
+	assertStatus(t, http.StatusPartialContent, w)
+	assert.Equal(t, []byte{0xff, 0xd8}, w.Body.Bytes()) // JPEG SOI 마커
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, image.URL+"0", "", nil, ""))
+
+	// 다른 사용자의 이미지는 조회하거나 삭제할 수 없고, 없는 이미지는 404
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/"), otherToken, nil, ""))
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/file"), otherToken, nil, ""))
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/categories"), otherToken, nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, imagePath("/api/user/images", 9999, "/"), token, nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, imagePath("/api/user/images", 9999, "/file"), token, nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, imagePath("/api/user/images", 9999, "/categories"), token, nil, ""))
+	w = server.do(http.MethodDelete, imagePath("/api/user/images", uploaded.ID, ""), otherToken, nil, "")
+	assert.NotEqual(t, http.StatusOK, w.Code)
+
+	assertStatus(t, http.StatusOK, server.do(http.MethodDelete, imagePath("/api/user/images", uploaded.ID, ""), token, nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/"), token, nil, ""))
+}
+
+// 태그, 카테고리, 앨범을 이미지에 붙이고 태그와 카테고리로 이미지를 찾는지 테스트
+func TestHTTPOrganizeImages(t *testing.T) {
+	server := newTestServer(t)
+	_, token := server.signUp("user@example.com", "user-password")
+	cat := server.uploadImage("/api/user/images", token, "cat.jpg")
+	dog := server.uploadImage("/api/user/images", token, "dog.jpg")
+
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, imagePath("/api/user/images", cat.ID, "/tags"), token, gin.H{"tags": []string{"#Cute", "home"}}))
+	w := server.do(http.MethodGet, "/api/user/tags/cute/images", token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var images []models.Image
+	decodeJSON(t, w, &images)
+	assert.Len(t, images, 1)
+	assert.Equal(t, cat.ID, images[0].ID)
+	assertStatus(t, http.StatusOK, server.do(http.MethodDelete, imagePath("/api/user/images", cat.ID, "/tags/home"), token, nil, ""))
+
+	animalID := server.categoryID("ANIMAL")
+	categoryPath := "/api/user/categories/" + uintString(animalID) + "/images"
+	assertStatus(t, http.StatusOK, server.do(http.MethodPost, categoryPath+"/"+uintString(dog.ID)+"/", token, nil, ""))
+	w = server.do(http.MethodGet, categoryPath, token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	decodeJSON(t, w, &images)
+	assert.Len(t, images, 1)
+	assert.Equal(t, dog.ID, images[0].ID)
+
+	w = server.doJSON(http.MethodPost, "/api/user/albums", token, gin.H{"title": "반려동물"})
+	assertStatus(t, http.StatusOK, w)
+	var created struct {
+		Album models.Album `json:"album"`
+	}
+	decodeJSON(t, w, &created)
+	albumPath := "/api/user/albums/" + uintString(created.Album.ID)
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, albumPath+"/images", token, gin.H{"image_ids": []uint{cat.ID, dog.ID}}))
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPut, albumPath+"/images/order", token, gin.H{"image_ids": []uint{dog.ID, cat.ID}}))
+
+	w = server.do(http.MethodGet, albumPath, token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var album models.AlbumDetail
+	decodeJSON(t, w, &album)
+	if assert.Len(t, album.Images, 2) {
+		assert.Equal(t, dog.ID, album.Images[0].ID)
+	}
+
+	// 앨범을 압축하면 앨범 순서대로 담기고, 다른 사용자는 압축할 수 없음
+	w = server.doJSON(http.MethodPost, "/api/user/images/archive", token, gin.H{"album_id": created.Album.ID})
+	assertStatus(t, http.StatusOK, w)
+	manifest := archiveManifest(t, w.Body.Bytes())
+	if assert.Len(t, manifest.Images, 2) {
+		assert.Equal(t, []uint{dog.ID, cat.ID}, []uint{manifest.Images[0].ID, manifest.Images[1].ID})
+	}
+	_, otherToken := server.signUp("other@example.com", "other-password")
+	assertStatus(t, http.StatusForbidden, server.doJSON(http.MethodPost, "/api/user/images/archive", otherToken, gin.H{"album_id": created.Album.ID}))
+
+	// 앨범 목록의 미리보기는 대표 이미지를 맨 앞에 두고 앨범 순서대로 보여줌
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPatch, albumPath, token, gin.H{"cover_image_id": cat.ID}))
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, "/api/user/albums", token, gin.H{"title": "빈 앨범"}))
+	w = server.do(http.MethodGet, "/api/user/albums", token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var albums []models.AlbumSummary
+	decodeJSON(t, w, &albums)
+	if assert.Len(t, albums, 2) {
+		assert.Empty(t, albums[0].Thumbnails)
+		assert.Equal(t, created.Album.ID, albums[1].ID)
+		assert.Equal(t, int64(2), albums[1].ImageCount)
+		if assert.Len(t, albums[1].Thumbnails, 2) {
+			assert.Equal(t, cat.ID, albums[1].Thumbnails[0].ID)
+			assert.True(t, strings.HasPrefix(albums[1].Thumbnails[0].ThumbnailURL, "/files/"))
+			assert.Equal(t, dog.ID, albums[1].Thumbnails[1].ID)
+		}
+	}
+}
+
+// 공유 링크와 다른 사용자에게 부여한 권한으로 이미지에 접근하고, 회수하면 접근할 수 없는지 테스트
+func TestHTTPSharing(t *testing.T) {
+	server := newTestServer(t)
+	_, ownerToken := server.signUp("owner@example.com", "owner-password")
+	viewer, viewerToken := server.signUp("viewer@example.com", "viewer-password")
+	uploaded := server.uploadImage("/api/user/images", ownerToken, "cat.jpg")
+
+	w := server.doJSON(http.MethodPost, imagePath("/api/user/images", uploaded.ID, "/shares"), ownerToken, gin.H{"password": "share-password"})
+	assertStatus(t, http.StatusOK, w)
+	var share struct {
+		URL string `json:"url"`
+	}
+	decodeJSON(t, w, &share)
+	assertStatus(t, http.StatusUnauthorized, server.do(http.MethodGet, share.URL, "", nil, ""))
+
+	// 비밀번호는 쿼리로 받지 않고 X-Share-Password 헤더나 POST 본문으로만 받음
+	assertStatus(t, http.StatusUnauthorized, server.do(http.MethodGet, share.URL+"?password=share-password", "", nil, ""))
+	req := httptest.NewRequest(http.MethodGet, share.URL, nil)
+	req.Header.Set("X-Share-Password", "share-password")
+	w = httptest.NewRecorder()
+	server.handler.ServeHTTP(w, req)
+	assertStatus(t, http.StatusOK, w)
+	assert.Equal(t, "no-referrer", w.Header().Get("Referrer-Policy"))
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, share.URL, "", gin.H{"password": "share-password"}))
+	form := strings.NewReader("password=share-password")
+	assertStatus(t, http.StatusOK, server.do(http.MethodPost, share.URL+"/thumbnail", "", form, "application/x-www-form-urlencoded"))
+
+	// 썸네일은 조회 수에 포함되지 않음
+	w = server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/shares"), ownerToken, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var shares []models.ImageShare
+	decodeJSON(t, w, &shares)
+	if assert.Len(t, shares, 1) {
+		assert.Equal(t, 2, shares[0].ViewCount)
+	}
+
+	permissionsPath := imagePath("/api/user/images", uploaded.ID, "/permissions")
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, permissionsPath, ownerToken, gin.H{"email": viewer.Email, "role": "VIEWER"}))
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/"), viewerToken, nil, ""))
+
+	w = server.do(http.MethodGet, "/api/user/images/shared", viewerToken, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var shared []models.SharedImage
+	decodeJSON(t, w, &shared)
+	if assert.Len(t, shared, 1) {
+		assert.Equal(t, models.ImageRoleViewer, shared[0].Role)
+		assert.NotEmpty(t, shared[0].URL)
+		assert.NotEmpty(t, shared[0].ThumbnailURL)
+		assertStatus(t, http.StatusOK, server.do(http.MethodGet, shared[0].ThumbnailURL, "", nil, ""))
+	}
+
+	assertStatus(t, http.StatusOK, server.do(http.MethodDelete, permissionsPath+"/"+uintString(viewer.ID), ownerToken, nil, ""))
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/"), viewerToken, nil, ""))
+}
+
+// 관리자가 사용자 대신 업로드하고, 모든 이미지와 카테고리 통계를 조회하고, 사용자의 이미지를 삭제하는지 테스트
+func TestHTTPAdminImages(t *testing.T) {
+	server := newTestServer(t)
+	user, userToken := server.signUp("user@example.com", "user-password")
+	adminToken := server.adminToken()
+
+	server.uploadImage("/api/user/images", userToken, "cat.jpg", "ANIMAL")
+	uploaded := server.uploadImage("/api/admin/images/"+uintString(user.ID)+"/", adminToken, "dog.jpg", "ANIMAL")
+	assert.Equal(t, user.ID, uploaded.UserID)
+
+	w := server.do(http.MethodGet, "/api/admin/images", adminToken, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var images []models.Image
+	decodeJSON(t, w, &images)
+	assert.Len(t, images, 2)
+
+	w = server.do(http.MethodGet, "/api/admin/categories/stats", adminToken, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var stats models.CategoryStats
+	decodeJSON(t, w, &stats)
+	if assert.NotEmpty(t, stats.DailyUploads) {
+		assert.Regexp(t, `^\d{4}-\d{2}-\d{2}$`, stats.DailyUploads[0].Date) // 날짜는 DB에서 YYYY-MM-DD로 집계
+	}
+
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, imagePath("/api/admin/images", uploaded.ID, "/"), adminToken, nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, imagePath("/api/admin/images", 9999, "/"), adminToken, nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, imagePath("/api/admin/images", 9999, "/file"), adminToken, nil, ""))
+
+	// 관리자는 태그 그룹의 경로로 태그를 붙임, 다른 사용자의 이미지는 403, 없는 이미지는 404
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, "/api/admin/tags/images/"+uintString(uploaded.ID), adminToken, gin.H{"tags": []string{"dog"}}))
+	_, otherToken := server.signUp("other@example.com", "other-password")
+	assertStatus(t, http.StatusForbidden, server.doJSON(http.MethodPost, imagePath("/api/user/images", uploaded.ID, "/tags"), otherToken, gin.H{"tags": []string{"dog"}}))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, imagePath("/api/user/images", 9999, "/tags"), userToken, nil, ""))
+	assertStatus(t, http.StatusOK, server.do(http.MethodDelete, "/api/admin/images/users/"+uintString(user.ID)+"/images", adminToken, nil, ""))
+}
+
+// 일괄 가져오기는 작업으로 요청하고, 백그라운드에서 끝나면 결과 파일을 내려받을 수 있는지 테스트
+func TestHTTPBulkImport(t *testing.T) {
+	server := newTestServer(t)
+	user, userToken := server.signUp("user@example.com", "user-password")
+	adminToken := server.adminToken()
+
+	var archive bytes.Buffer
+	zipWriter := zip.NewWriter(&archive)
+	for name, data := range map[string][]byte{
+		"cat.jpg":      fixtureJPEG(t, 64, 48),
+		"metadata.csv": []byte("file,owner_email,categories\ncat.jpg,user@example.com,ANIMAL\nmissing.jpg,user@example.com,\n"),
+	} {
+		fileWriter, err := zipWriter.Create(name)
+		require.NoError(t, err)
+		_, err = fileWriter.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zipWriter.Close())
+
+	bulkImport := func(token string, archiveData []byte) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		part, err := writer.CreateFormFile("archive", "photos.zip")
+		require.NoError(t, err)
+		_, err = part.Write(archiveData)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		return server.do(http.MethodPost, "/api/admin/images/bulk-import", token, &body, writer.FormDataContentType())
+	}
+
+	assertStatus(t, http.StatusForbidden, bulkImport(userToken, archive.Bytes()))
+	assertStatus(t, http.StatusBadRequest, bulkImport(adminToken, []byte("not a zip")))
+
+	w := bulkImport(adminToken, archive.Bytes())
+	assertStatus(t, http.StatusAccepted, w)
+	var requested struct {
+		Job models.BulkImportJob `json:"job"`
+	}
+	decodeJSON(t, w, &requested)
+	assert.Equal(t, models.BulkImportJobStatusPending, requested.Job.Status)
+	assert.Equal(t, 2, requested.Job.ItemCount)
+
+	jobPath := "/api/admin/images/bulk-import/" + uintString(requested.Job.ID)
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, jobPath, userToken, nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, "/api/admin/images/bulk-import/9999", adminToken, nil, ""))
+
+	var job struct {
+		Job models.BulkImportJob `json:"job"`
+	}
+	require.Eventually(t, func() bool {
+		w := server.do(http.MethodGet, jobPath, adminToken, nil, "")
+		return json.Unmarshal(w.Body.Bytes(), &job) == nil && job.Job.Status == models.BulkImportJobStatusCompleted
+	}, 5*time.Second, 20*time.Millisecond)
+	assert.Equal(t, 1, job.Job.Imported)
+	assert.Equal(t, 1, job.Job.Failed)
+
+	var images []models.Image
+	require.NoError(t, server.db.Where("user_id = ?", user.ID).Find(&images).Error)
+	assert.Len(t, images, 1)
+
+	w = server.do(http.MethodGet, job.Job.ReportURL, "", nil, "")
+	assertStatus(t, http.StatusOK, w)
+	report := w.Body.String()
+	assert.True(t, strings.HasPrefix(report, "line,file,owner_email,status"))
+	assert.Contains(t, report, "cat.jpg,user@example.com,imported")
+	assert.Contains(t, report, "missing.jpg,user@example.com,failed")
+}
+
+// tus 프로토콜로 나눠서 업로드하면 마지막 조각에서 이미지가 만들어지고, 다른 사용자는 업로드에 접근할 수 없는지 테스트
+func TestHTTPResumableUpload(t *testing.T) {
+	server := newTestServer(t)
+	_, token := server.signUp("user@example.com", "user-password")
+	_, otherToken := server.signUp("other@example.com", "other-password")
+	data := fixtureJPEG(t, 64, 48)
+	half := len(data) / 2
+
+	w := server.do(http.MethodOptions, "/api/user/uploads", token, nil, "")
+	assertStatus(t, http.StatusNoContent, w)
+	assert.Equal(t, "1.0.0", w.Header().Get("Tus-Version"))
+
+	metadata := "filename " + base64.StdEncoding.EncodeToString([]byte("cat.jpg")) + ",categories " + base64.StdEncoding.EncodeToString([]byte("ANIMAL"))
+	createHeaders := map[string]string{"Upload-Length": strconv.Itoa(len(data)), "Upload-Metadata": metadata}
+	assertStatus(t, http.StatusPreconditionFailed, server.doHeaders(http.MethodPost, "/api/user/uploads", token, nil, createHeaders))
+	createHeaders["Tus-Resumable"] = "1.0.0"
+	assertStatus(t, http.StatusForbidden, server.doHeaders(http.MethodPost, "/api/user/uploads", server.adminToken(), nil, createHeaders))
+
+	w = server.doHeaders(http.MethodPost, "/api/user/uploads", token, nil, createHeaders)
+	assertStatus(t, http.StatusCreated, w)
+	location := w.Header().Get("Location")
+	require.True(t, strings.HasPrefix(location, "/api/user/uploads/"), location)
+
+	chunkHeaders := func(offset int) map[string]string {
+		return map[string]string{
+			"Tus-Resumable": "1.0.0",
+			"Content-Type":  "application/offset+octet-stream",
+			"Upload-Offset": strconv.Itoa(offset),
+		}
+	}
+	w = server.doHeaders(http.MethodPatch, location, token, bytes.NewReader(data[:half]), chunkHeaders(0))
+	assertStatus(t, http.StatusNoContent, w)
+	assert.Equal(t, strconv.Itoa(half), w.Header().Get("Upload-Offset"))
+
+	// 다른 사용자는 업로드 위치를 조회하거나 이어쓸 수 없음
+	assertStatus(t, http.StatusNotFound, server.doHeaders(http.MethodHead, location, otherToken, nil, map[string]string{"Tus-Resumable": "1.0.0"}))
+	assertStatus(t, http.StatusNotFound, server.doHeaders(http.MethodPatch, location, otherToken, bytes.NewReader(data[half:]), chunkHeaders(half)))
+
+	w = server.doHeaders(http.MethodHead, location, token, nil, map[string]string{"Tus-Resumable": "1.0.0"})
+	assertStatus(t, http.StatusOK, w)
+	assert.Equal(t, strconv.Itoa(half), w.Header().Get("Upload-Offset"))
+
+	w = server.doHeaders(http.MethodPatch, location, token, bytes.NewReader(data[half:]), chunkHeaders(half))
+	assertStatus(t, http.StatusNoContent, w)
+	imageID := w.Header().Get("X-Image-ID")
+	require.NotEmpty(t, imageID)
+
+	w = server.do(http.MethodGet, "/api/user/images/"+imageID+"/", token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var image models.Image
+	decodeJSON(t, w, &image)
+	assert.Equal(t, "cat.jpg", image.FileName)
+}
+
+// URL로 이미지를 가져오고, 사용자는 관리자의 대신 가져오기를 쓸 수 없는지 테스트
+func TestHTTPImportFromURL(t *testing.T) {
+	// 테스트 이미지 서버가 루프백 주소에서 돌기 때문에 허용
+	server := newTestServer(t, func(cfg *config.Config) {
+		cfg.Import.AllowedNetworks = []string{"127.0.0.1/32"}
+	})
+	user, token := server.signUp("user@example.com", "user-password")
+	adminToken := server.adminToken()
+
+	data := fixtureJPEG(t, 64, 48)
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		w.Write(data)
+	}))
+	defer origin.Close()
+
+	w := server.doJSON(http.MethodPost, "/api/user/images/import", token, gin.H{"url": origin.URL + "/cat.jpg", "categories": []string{"ANIMAL"}})
+	assertStatus(t, http.StatusOK, w)
+	var imported struct {
+		Image models.Image `json:"image"`
+	}
+	decodeJSON(t, w, &imported)
+	assert.Equal(t, "cat.jpg", imported.Image.FileName)
+	assert.Equal(t, user.ID, imported.Image.UserID)
+	assert.True(t, strings.HasPrefix(imported.Image.URL, "/files/"))
+
+	adminImportPath := "/api/admin/images/users/" + uintString(user.ID) + "/import"
+	assertStatus(t, http.StatusForbidden, server.doJSON(http.MethodPost, adminImportPath, token, gin.H{"url": origin.URL + "/dog.jpg"}))
+	assertStatus(t, http.StatusUnauthorized, server.doJSON(http.MethodPost, "/api/user/images/import", "", gin.H{"url": origin.URL + "/dog.jpg"}))
+
+	w = server.doJSON(http.MethodPost, adminImportPath, adminToken, gin.H{"url": origin.URL + "/dog.jpg"})
+	assertStatus(t, http.StatusOK, w)
+	decodeJSON(t, w, &imported)
+	assert.Equal(t, user.ID, imported.Image.UserID)
+}
+
+// 선택한 이미지를 ZIP으로 내려받고, 권한이 없거나 없는 이미지는 압축하지 않는지 테스트
+func TestHTTPArchive(t *testing.T) {
+	server := newTestServer(t)
+	_, token := server.signUp("user@example.com", "user-password")
+	other, otherToken := server.signUp("other@example.com", "other-password")
+	cat := server.uploadImage("/api/user/images", token, "cat.jpg", "ANIMAL")
+	dog := server.uploadImage("/api/user/images", otherToken, "dog.jpg")
+
+	w := server.doJSON(http.MethodPost, "/api/user/images/archive", token, gin.H{"image_ids": []uint{cat.ID}})
+	assertStatus(t, http.StatusOK, w)
+	assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+	manifest := archiveManifest(t, w.Body.Bytes())
+	if assert.Len(t, manifest.Images, 1) {
+		assert.Equal(t, cat.ID, manifest.Images[0].ID)
+		assert.Equal(t, []string{"ANIMAL"}, manifest.Images[0].Categories)
+	}
+
+	assertStatus(t, http.StatusForbidden, server.doJSON(http.MethodPost, "/api/user/images/archive", token, gin.H{"image_ids": []uint{cat.ID, dog.ID}}))
+	assertStatus(t, http.StatusNotFound, server.doJSON(http.MethodPost, "/api/user/images/archive", token, gin.H{"image_ids": []uint{9999}}))
+	assertStatus(t, http.StatusForbidden, server.doJSON(http.MethodPost, "/api/admin/images/archive", token, gin.H{"user_id": other.ID}))
+
+	// 관리자는 지정한 사용자의 이미지를 압축
+	w = server.doJSON(http.MethodPost, "/api/admin/images/archive", server.adminToken(), gin.H{"user_id": other.ID})
+	assertStatus(t, http.StatusOK, w)
+	manifest = archiveManifest(t, w.Body.Bytes())
+	if assert.Len(t, manifest.Images, 1) {
+		assert.Equal(t, dog.ID, manifest.Images[0].ID)
+	}
+
+	// 관리자가 사용자를 지정하지 않으면 카테고리와 업로드 날짜 조건으로 모든 사용자의 이미지를 고름
+	today := time.Now().Format(time.DateOnly)
+	w = server.doJSON(http.MethodPost, "/api/admin/images/archive", server.adminToken(), gin.H{"category_id": server.categoryID("ANIMAL"), "from": today, "to": today})
+	assertStatus(t, http.StatusOK, w)
+	manifest = archiveManifest(t, w.Body.Bytes())
+	if assert.Len(t, manifest.Images, 1) {
+		assert.Equal(t, cat.ID, manifest.Images[0].ID)
+	}
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(time.DateOnly)
+	assertStatus(t, http.StatusNotFound, server.doJSON(http.MethodPost, "/api/admin/images/archive", server.adminToken(), gin.H{"from": tomorrow}))
+}
+
+// 계정 데이터 내보내기를 요청하면 백그라운드에서 압축하고, 다른 사용자는 작업을 조회할 수 없는지 테스트
+func TestHTTPExport(t *testing.T) {
+	server := newTestServer(t)
+	_, token := server.signUp("user@example.com", "user-password")
+	_, otherToken := server.signUp("other@example.com", "other-password")
+	server.uploadImage("/api/user/images", token, "cat.jpg")
+
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodPost, "/api/user/export", server.adminToken(), nil, ""))
+
+	w := server.doJSON(http.MethodPost, "/api/user/export", token, gin.H{"include_renditions": true})
+	assertStatus(t, http.StatusAccepted, w)
+	var requested struct {
+		Job models.ExportJob `json:"job"`
+	}
+	decodeJSON(t, w, &requested)
+	jobPath := "/api/user/export/" + uintString(requested.Job.ID)
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, jobPath, otherToken, nil, ""))
+
+	var job struct {
+		Job models.ExportJob `json:"job"`
+	}
+	require.Eventually(t, func() bool {
+		w := server.do(http.MethodGet, jobPath, token, nil, "")
+		return json.Unmarshal(w.Body.Bytes(), &job) == nil && job.Job.Status == models.ExportStatusCompleted
+	}, 5*time.Second, 20*time.Millisecond)
+	assert.Equal(t, 1, job.Job.ImageCount)
+
+	w = server.do(http.MethodGet, "/api/user/export", token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var jobs struct {
+		Jobs []models.ExportJob `json:"jobs"`
+	}
+	decodeJSON(t, w, &jobs)
+	assert.Len(t, jobs.Jobs, 1)
+	w = server.do(http.MethodGet, "/api/user/export", otherToken, nil, "")
+	decodeJSON(t, w, &jobs)
+	assert.Empty(t, jobs.Jobs)
+
+	w = server.do(http.MethodGet, job.Job.DownloadURL, "", nil, "")
+	assertStatus(t, http.StatusOK, w)
+	manifest := archiveManifest(t, w.Body.Bytes())
+	assert.Equal(t, 1, manifest.ImageCount)
+}
+
+// 여러 이미지의 카테고리를 한 번에 바꾸고, 권한이 없는 이미지는 결과에 실패로 남기는지 테스트
+func TestHTTPBulkCategories(t *testing.T) {
+	server := newTestServer(t)
+	_, token := server.signUp("user@example.com", "user-password")
+	_, otherToken := server.signUp("other@example.com", "other-password")
+	cat := server.uploadImage("/api/user/images", token, "cat.jpg")
+	dog := server.uploadImage("/api/user/images", otherToken, "dog.jpg")
+	animalID := server.categoryID("ANIMAL")
+
+	w := server.doJSON(http.MethodPost, "/api/user/categories/bulk", token, gin.H{"action": "add", "image_ids": []uint{cat.ID, dog.ID}, "category_ids": []uint{animalID}})
+	assertStatus(t, http.StatusOK, w)
+	var bulk struct {
+		Results []services.BulkCategoryResult `json:"results"`
+	}
+	decodeJSON(t, w, &bulk)
+	if assert.Len(t, bulk.Results, 2) {
+		assert.True(t, bulk.Results[0].Success)
+		assert.False(t, bulk.Results[1].Success)
+		assert.NotEmpty(t, bulk.Results[1].Error)
+	}
+
+	w = server.do(http.MethodGet, imagePath("/api/user/images", dog.ID, "/categories"), otherToken, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var categories []models.Category
+	decodeJSON(t, w, &categories)
+	assert.Empty(t, categories)
+
+	assertStatus(t, http.StatusBadRequest, server.doJSON(http.MethodPost, "/api/user/categories/bulk", token, gin.H{"action": "move", "image_ids": []uint{cat.ID}, "category_ids": []uint{animalID}}))
+	assertStatus(t, http.StatusForbidden, server.doJSON(http.MethodPost, "/api/admin/categories/bulk", token, gin.H{"action": "set", "image_ids": []uint{dog.ID}, "category_ids": []uint{animalID}}))
+
+	// 관리자는 모든 사용자의 이미지 카테고리를 바꿈
+	w = server.doJSON(http.MethodPost, "/api/admin/categories/bulk", server.adminToken(), gin.H{"action": "set", "image_ids": []uint{dog.ID}, "category_ids": []uint{animalID}})
+	assertStatus(t, http.StatusOK, w)
+	decodeJSON(t, w, &bulk)
+	if assert.Len(t, bulk.Results, 1) {
+		assert.True(t, bulk.Results[0].Success, bulk.Results[0].Error)
+	}
+}
+
+// 태그를 접두어로 자동완성하고, 인증이나 사용자 권한이 없으면 거절하는지 테스트
+func TestHTTPTagSearch(t *testing.T) {
+	server := newTestServer(t)
+	_, token := server.signUp("user@example.com", "user-password")
+	cat := server.uploadImage("/api/user/images", token, "cat.jpg")
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, imagePath("/api/user/images", cat.ID, "/tags"), token, gin.H{"tags": []string{"home", "holiday", "cat"}}))
+
+	w := server.do(http.MethodGet, "/api/user/tags?prefix=ho", token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var tags []models.Tag
+	decodeJSON(t, w, &tags)
+	assert.Len(t, tags, 2)
+
+	// 입력한 접두어도 태그명과 같은 규칙으로 정규화
+	w = server.do(http.MethodGet, "/api/user/tags?prefix=%23HOL", token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	decodeJSON(t, w, &tags)
+	if assert.Len(t, tags, 1) {
+		assert.Equal(t, "holiday", tags[0].Name)
+	}
+
+	// 다른 사용자의 이미지에만 붙은 태그는 보이지 않고, 권한을 받으면 보임
+	_, ownerToken := server.signUp("owner@example.com", "owner-password")
+	dog := server.uploadImage("/api/user/images", ownerToken, "dog.jpg")
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, imagePath("/api/user/images", dog.ID, "/tags"), ownerToken, gin.H{"tags": []string{"hospital"}}))
+	w = server.do(http.MethodGet, "/api/user/tags?prefix=ho", token, nil, "")
+	decodeJSON(t, w, &tags)
+	assert.Len(t, tags, 2)
+	w = server.do(http.MethodGet, "/api/user/tags?prefix=ho", ownerToken, nil, "")
+	decodeJSON(t, w, &tags)
+	if assert.Len(t, tags, 1) {
+		assert.Equal(t, "hospital", tags[0].Name)
+	}
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, imagePath("/api/user/images", dog.ID, "/permissions"), ownerToken, gin.H{"email": "user@example.com", "role": "VIEWER"}))
+	w = server.do(http.MethodGet, "/api/user/tags?prefix=ho", token, nil, "")
+	decodeJSON(t, w, &tags)
+	assert.Len(t, tags, 3)
+
+	// 관리자는 모든 사용자의 태그를 자동완성
+	w = server.do(http.MethodGet, "/api/admin/tags?prefix=hos", server.adminToken(), nil, "")
+	assertStatus(t, http.StatusOK, w)
+	decodeJSON(t, w, &tags)
+	assert.Len(t, tags, 1)
+
+	assertStatus(t, http.StatusUnauthorized, server.do(http.MethodGet, "/api/user/tags?prefix=ho", "", nil, ""))
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, "/api/user/tags?prefix=ho", server.adminToken(), nil, ""))
+}
+
+// 공유 링크를 폐기하면 더 이상 열 수 없고, 소유자가 아니면 폐기할 수 없는지 테스트
+func TestHTTPRevokeShare(t *testing.T) {
+	server := newTestServer(t)
+	_, token := server.signUp("user@example.com", "user-password")
+	_, otherToken := server.signUp("other@example.com", "other-password")
+	cat := server.uploadImage("/api/user/images", token, "cat.jpg")
+
+	w := server.doJSON(http.MethodPost, imagePath("/api/user/images", cat.ID, "/shares"), token, gin.H{})
+	assertStatus(t, http.StatusOK, w)
+	var created struct {
+		Share models.ImageShare `json:"share"`
+		URL   string            `json:"url"`
+	}
+	decodeJSON(t, w, &created)
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, created.URL, "", nil, ""))
+
+	sharePath := imagePath("/api/user/images", cat.ID, "/shares/"+uintString(created.Share.ID))
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodDelete, sharePath, otherToken, nil, ""))
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, created.URL, "", nil, ""))
+
+	assertStatus(t, http.StatusOK, server.do(http.MethodDelete, sharePath, token, nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, created.URL, "", nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodDelete, sharePath, token, nil, ""))
+
+	// 관리자는 다른 사용자의 공유 링크도 폐기
+	w = server.doJSON(http.MethodPost, imagePath("/api/user/images", cat.ID, "/shares"), token, gin.H{})
+	decodeJSON(t, w, &created)
+	assertStatus(t, http.StatusOK, server.do(http.MethodDelete, imagePath("/api/admin/images", cat.ID, "/shares/"+uintString(created.Share.ID)), server.adminToken(), nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, created.URL, "", nil, ""))
+}
+
+// 썸네일은 소유자와 권한을 부여받은 사용자만 조회할 수 있는지 테스트
+func TestHTTPThumbnail(t *testing.T) {
+	server := newTestServer(t)
+	_, token := server.signUp("user@example.com", "user-password")
+	viewer, viewerToken := server.signUp("viewer@example.com", "viewer-password")
+	cat := server.uploadImage("/api/user/images", token, "cat.jpg")
+	thumbnailPath := imagePath("/api/user/images/thumbnail", cat.ID, "/")
+
+	w := server.do(http.MethodGet, thumbnailPath, token, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	assert.Equal(t, "image/jpeg", w.Header().Get("Content-Type"))
+	assert.NotEmpty(t, w.Header().Get("ETag"))
+
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, thumbnailPath, viewerToken, nil, ""))
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, thumbnailPath, server.adminToken(), nil, ""))
+
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, imagePath("/api/user/images", cat.ID, "/permissions"), token, gin.H{"email": viewer.Email, "role": "VIEWER"}))
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, thumbnailPath, viewerToken, nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, imagePath("/api/user/images/thumbnail", 9999, "/"), token, nil, ""))
+}
+
+// 관리자가 태그로 모든 사용자의 이미지를 찾고, 사용자 대신 앨범을 관리하는지 테스트
+func TestHTTPAdminTagsAndAlbums(t *testing.T) {
+	server := newTestServer(t)
+	user, token := server.signUp("user@example.com", "user-password")
+	_, otherToken := server.signUp("other@example.com", "other-password")
+	adminToken := server.adminToken()
+	cat := server.uploadImage("/api/user/images", token, "cat.jpg")
+	dog := server.uploadImage("/api/user/images", token, "dog.jpg")
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, imagePath("/api/user/images", cat.ID, "/tags"), token, gin.H{"tags": []string{"cat"}}))
+
+	w := server.do(http.MethodGet, "/api/admin/tags?prefix=ca", adminToken, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var tags []models.Tag
+	decodeJSON(t, w, &tags)
+	assert.Len(t, tags, 1)
+
+	w = server.do(http.MethodGet, "/api/admin/tags/cat/images", adminToken, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var images []models.Image
+	decodeJSON(t, w, &images)
+	if assert.Len(t, images, 1) {
+		assert.Equal(t, cat.ID, images[0].ID)
+	}
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, "/api/admin/tags?prefix=ca", token, nil, ""))
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodGet, "/api/admin/tags/cat/images", token, nil, ""))
+
+	userAlbumsPath := "/api/admin/albums/users/" + uintString(user.ID)
+	assertStatus(t, http.StatusForbidden, server.doJSON(http.MethodPost, userAlbumsPath, token, gin.H{"title": "반려동물"}))
+	w = server.doJSON(http.MethodPost, userAlbumsPath, adminToken, gin.H{"title": "반려동물"})
+	assertStatus(t, http.StatusOK, w)
+	var created struct {
+		Album models.Album `json:"album"`
+	}
+	decodeJSON(t, w, &created)
+	assert.Equal(t, user.ID, created.Album.UserID)
+
+	albumPath := "/api/admin/albums/" + uintString(created.Album.ID)
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPost, albumPath+"/images", adminToken, gin.H{"image_ids": []uint{cat.ID, dog.ID}}))
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPut, albumPath+"/images/order", adminToken, gin.H{"image_ids": []uint{dog.ID, cat.ID}}))
+	assertStatus(t, http.StatusOK, server.doJSON(http.MethodPatch, albumPath, adminToken, gin.H{"title": "동물"}))
+	assertStatus(t, http.StatusOK, server.do(http.MethodDelete, albumPath+"/images/"+uintString(cat.ID), adminToken, nil, ""))
+
+	w = server.do(http.MethodGet, albumPath, adminToken, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var album models.AlbumDetail
+	decodeJSON(t, w, &album)
+	assert.Equal(t, "동물", album.Title)
+	if assert.Len(t, album.Images, 1) {
+		assert.Equal(t, dog.ID, album.Images[0].ID)
+	}
+
+	w = server.do(http.MethodGet, userAlbumsPath, adminToken, nil, "")
+	assertStatus(t, http.StatusOK, w)
+	var albums []models.AlbumSummary
+	decodeJSON(t, w, &albums)
+	assert.Len(t, albums, 1)
+
+	// 앨범 소유자가 아닌 사용자에게는 관리자가 만든 앨범이 보이지 않음
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, "/api/user/albums/"+uintString(created.Album.ID), otherToken, nil, ""))
+	assertStatus(t, http.StatusForbidden, server.doJSON(http.MethodPatch, "/api/user/albums/"+uintString(created.Album.ID), otherToken, gin.H{"title": "가로채기"}))
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, "/api/user/albums/"+uintString(created.Album.ID), token, nil, ""))
+	assertStatus(t, http.StatusForbidden, server.do(http.MethodDelete, albumPath, token, nil, ""))
+	assertStatus(t, http.StatusOK, server.do(http.MethodDelete, albumPath, adminToken, nil, ""))
+}
@@ -0,0 +1,220 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/initializers"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
+	"github.com/zeze1004/image-hub-platform/migrations"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/server"
+	"gorm.io/gorm"
+	"image"
+	"image/jpeg"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// 실제 라우터, 서비스, 저장소를 SQLite와 임시 업로드 디렉토리로 띄워서 HTTP 요청으로 테스트하는 서버
+
+const (
+	testAdminEmail    = "admin@example.com"
+	testAdminPassword = "admin-password"
+)
+
+type testServer struct {
+	t          *testing.T
+	handler    http.Handler
+	db         *gorm.DB
+	config     *config.Config
+	background *lifecycle.Registry
+}
+
+// newTestServer 테스트마다 새 SQLite DB와 업로드 디렉토리로 서버 생성
+// 스키마와 시드 데이터(기본 카테고리, 관리자 계정) 마이그레이션을 적용한 상태로 시작, configure로 설정을 바꿀 수 있음
+func newTestServer(t *testing.T, configure ...func(cfg *config.Config)) *testServer {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	dir := t.TempDir()
+	cfg := *testConfig
+	cfg.Database = config.DatabaseConfig{Driver: config.DriverSQLite, Path: filepath.Join(dir, "image_hub.db")}
+	cfg.Storage.UploadsDir = filepath.Join(dir, "uploads")
+	for _, fn := range configure {
+		fn(&cfg)
+	}
+
+	db, err := initializers.OpenDB(cfg.Database)
+	require.NoError(t, err)
+	admin := migrations.AdminAccount{Email: testAdminEmail, Password: testAdminPassword}
+	_, err = migrations.NewMigrator(db, append(migrations.Schema(), migrations.Seeds(admin)...)).Up()
+	require.NoError(t, err)
+
+	// 백그라운드 작업(파일 삭제, 내보내기 작업자 등)이 끝난 뒤에 DB를 닫음
+	background := lifecycle.NewRegistry()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		assert.NoError(t, background.Shutdown(ctx))
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	handler := server.NewHandler(&cfg, server.Dependencies{DB: db, Lifecycle: background})
+	return &testServer{t: t, handler: handler, db: db, config: &cfg, background: background}
+}
+
+// do 요청을 보내고 응답 반환, token이 있으면 Authorization 헤더에 그대로 설정 (Bearer 접두사 없음)
+func (s *testServer) do(method, path, token string, body io.Reader, contentType string) *httptest.ResponseRecorder {
+	s.t.Helper()
+	headers := make(map[string]string)
+	if contentType != "" {
+		headers["Content-Type"] = contentType
+	}
+	return s.doHeaders(method, path, token, body, headers)
+}
+
+// doHeaders headers를 설정해서 보내는 요청
+func (s *testServer) doHeaders(method, path, token string, body io.Reader, headers map[string]string) *httptest.ResponseRecorder {
+	s.t.Helper()
+	req := httptest.NewRequest(method, path, body)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+	w := httptest.NewRecorder()
+	s.handler.ServeHTTP(w, req)
+	return w
+}
+
+// doJSON body를 JSON으로 보내는 요청
+func (s *testServer) doJSON(method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	s.t.Helper()
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(s.t, err)
+		reader = bytes.NewReader(data)
+	}
+	return s.do(method, path, token, reader, "application/json")
+}
+
+// signUp 회원가입 후 로그인해서 사용자와 JWT 반환
+func (s *testServer) signUp(email, password string) (*models.User, string) {
+	s.t.Helper()
+	w := s.doJSON(http.MethodPost, "/auth/signup", "", gin.H{"email": email, "password": password})
+	require.Equal(s.t, http.StatusOK, w.Code, w.Body.String())
+
+	var user models.User
+	require.NoError(s.t, s.db.Where("email = ?", email).First(&user).Error)
+	return &user, s.login(email, password)
+}
+
+// login 로그인해서 JWT 반환
+func (s *testServer) login(email, password string) string {
+	s.t.Helper()
+	w := s.doJSON(http.MethodPost, "/auth/login", "", gin.H{"email": email, "password": password})
+	require.Equal(s.t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp struct {
+		Token string `json:"token"`
+	}
+	decodeJSON(s.t, w, &resp)
+	return resp.Token
+}
+
+// adminToken 시드 데이터로 만든 관리자 계정의 JWT
+func (s *testServer) adminToken() string {
+	return s.login(testAdminEmail, testAdminPassword)
+}
+
+// uploadImage path(사용자는 /api/user/images, 관리자는 /api/admin/images/:userID/)로 테스트 이미지를 업로드하고 업로드된 이미지 반환
+func (s *testServer) uploadImage(path, token, fileName string, categories ...string) models.Image {
+	s.t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("image", fileName)
+	require.NoError(s.t, err)
+	_, err = part.Write(fixtureJPEG(s.t, 64, 48))
+	require.NoError(s.t, err)
+	require.NoError(s.t, writer.WriteField("description", fileName+" 설명"))
+	for _, category := range categories {
+		require.NoError(s.t, writer.WriteField("categories", category))
+	}
+	require.NoError(s.t, writer.Close())
+
+	w := s.do(http.MethodPost, path, token, &body, writer.FormDataContentType())
+	require.Equal(s.t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp struct {
+		Image models.Image `json:"image"`
+	}
+	decodeJSON(s.t, w, &resp)
+	return resp.Image
+}
+
+// fixtureJPEG width x height 크기의 테스트용 JPEG
+func fixtureJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height)), nil))
+	return buf.Bytes()
+}
+
+func decodeJSON(t *testing.T, w *httptest.ResponseRecorder, v interface{}) {
+	t.Helper()
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), v), w.Body.String())
+}
+
+// archiveManifest 응답으로 받은 ZIP 파일의 manifest.json
+func archiveManifest(t *testing.T, data []byte) models.ArchiveManifest {
+	t.Helper()
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	manifestFile, err := zipReader.Open("manifest.json")
+	require.NoError(t, err)
+	defer manifestFile.Close()
+
+	var manifest models.ArchiveManifest
+	require.NoError(t, json.NewDecoder(manifestFile).Decode(&manifest))
+	return manifest
+}
+
+// categoryID 시드 데이터로 만든 카테고리의 ID
+func (s *testServer) categoryID(name string) uint {
+	s.t.Helper()
+	var category models.Category
+	require.NoError(s.t, s.db.Where("name = ?", name).First(&category).Error)
+	return category.ID
+}
+
+// imagePath prefix/imageID/suffix 형식의 이미지 API 경로
+func imagePath(prefix string, imageID uint, suffix string) string {
+	return fmt.Sprintf("%s/%d%s", prefix, imageID, suffix)
+}
+
+func uintString(id uint) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// assertStatus 응답 코드 확인, 실패하면 응답 본문을 함께 출력
+func assertStatus(t *testing.T, expected int, w *httptest.ResponseRecorder) {
+	t.Helper()
+	assert.Equal(t, expected, w.Code, w.Body.String())
+}
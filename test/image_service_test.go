@@ -9,6 +9,7 @@ import (
 	"github.com/zeze1004/image-hub-platform/mocks"
 	"github.com/zeze1004/image-hub-platform/models"
 	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/storage"
 	"image"
 	"image/jpeg"
 	"io"
@@ -17,6 +18,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -29,17 +31,16 @@ func TestUploadImage(t *testing.T) {
 	mockImageRepo := mocks.NewMockImageRepository(ctrl)
 	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
 	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
 
-	mockImageRepo.EXPECT().CreateImage(gomock.Any()).DoAndReturn(func(image *models.Image) error {
+	mockImageRepo.EXPECT().CreateImage(gomock.Any(), []uint{1}).DoAndReturn(func(image *models.Image, categoryIDs []uint) error {
 		image.ID = 1
 		return nil
 	})
 
 	mockCategoryRepo.EXPECT().GetCategoriesByName(gomock.Any()).Return([]models.Category{{ID: 1, Name: "TEST_CATEGORY"}}, nil)
 
-	mockImageCategoryRepo.EXPECT().AddImageCategory(gomock.Any(), gomock.Any()).Return(nil)
-
-	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo)
+	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo, storage.NewLocalStorage(), testConfig.Storage, testConfig.Image, nil)
 
 	gin.SetMode(gin.TestMode)
 	ctx, _ := gin.CreateTestContext(nil)
@@ -83,11 +84,12 @@ func TestUploadImage(t *testing.T) {
 	}
 	ctx.Request.Header.Set("Content-Type", writer.FormDataContentType())
 
-	uploadedImage, err := imageService.UploadImage(ctx, fileName, description, userID, categoryNames)
+	uploadedImage, _, err := imageService.UploadImage(ctx, fileName, description, userID, categoryNames, false)
 
 	assert.NoError(t, err)
 	assert.Equal(t, fileName, uploadedImage.FileName)
-	assert.Equal(t, filepath.Join(saveDir, fileName), uploadedImage.FilePath)
+	assert.Equal(t, filepath.Clean(saveDir), filepath.Dir(uploadedImage.FilePath))
+	assert.True(t, strings.HasSuffix(uploadedImage.FilePath, "_"+fileName), uploadedImage.FilePath)
 	assert.NotEmpty(t, uploadedImage.ThumbnailPath)
 	assert.Equal(t, description, uploadedImage.Description)
 	assert.Equal(t, userID, uploadedImage.UserID)
@@ -102,12 +104,14 @@ func TestUploadImageFailure(t *testing.T) {
 	mockImageRepo := mocks.NewMockImageRepository(ctrl)
 	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
 	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
 
 	// 카테고리가 달라서 이미지 생성 실패
 	mockCategoryRepo.EXPECT().GetCategoriesByName(gomock.Any()).Return([]models.Category{{ID: 1, Name: "TEST_CATEGORY"}}, nil)
-	mockImageRepo.EXPECT().CreateImage(gomock.Any()).Return(fmt.Errorf("이미지 생성에 실패했습니다"))
+	mockCategoryRepo.EXPECT().GetCategoryAliases(gomock.Any()).Return(nil, nil)
+	mockImageRepo.EXPECT().CreateImage(gomock.Any(), gomock.Any()).Return(fmt.Errorf("이미지 생성에 실패했습니다"))
 
-	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo)
+	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo, storage.NewLocalStorage(), testConfig.Storage, testConfig.Image, nil)
 
 	gin.SetMode(gin.TestMode)
 	ctx, _ := gin.CreateTestContext(nil)
@@ -151,11 +155,15 @@ func TestUploadImageFailure(t *testing.T) {
 	}
 	ctx.Request.Header.Set("Content-Type", writer.FormDataContentType())
 
-	uploadedImage, err := imageService.UploadImage(ctx, fileName, description, userID, categoryNames)
+	uploadedImage, _, err := imageService.UploadImage(ctx, fileName, description, userID, categoryNames, false)
 
 	assert.Error(t, err)
 	assert.Nil(t, uploadedImage)
 	assert.Contains(t, err.Error(), "이미지 생성에 실패했습니다")
+
+	// 메타데이터 저장에 실패하면 저장한 원본과 썸네일도 남지 않음
+	entries, _ := os.ReadDir(filepath.Join(testConfig.Storage.UploadsDir, "1"))
+	assert.Empty(t, entries)
 }
 
 // 모든 이미지 파일 삭제를 병렬로 처리할 떄와 순차 삭제 성능 비교
@@ -166,8 +174,9 @@ func BenchmarkDeleteAllImages(b *testing.B) {
 	mockImageRepo := mocks.NewMockImageRepository(ctrl)
 	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
 	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
 
-	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo)
+	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo, storage.NewLocalStorage(), testConfig.Storage, testConfig.Image, nil)
 
 	gin.SetMode(gin.TestMode)
 
@@ -249,3 +258,180 @@ func createTestFiles(images []models.Image) error {
 	}
 	return nil
 }
+
+// strict 모드에서 존재하지 않는 카테고리명이 있으면 파일을 저장하기 전에 업로드를 거절하는지 테스트
+func TestUploadImageStrictUnknownCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	mockCategoryRepo.EXPECT().GetCategoriesByName([]string{"ANIMAL", "ANIMALZ"}).Return([]models.Category{{ID: 3, Name: "ANIMAL"}}, nil)
+	mockCategoryRepo.EXPECT().GetCategoryAliases([]string{"ANIMALZ"}).Return(nil, nil)
+
+	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo, storage.NewLocalStorage(), testConfig.Storage, testConfig.Image, nil)
+
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(nil)
+
+	uploadedImage, _, err := imageService.UploadImage(ctx, "test.jpg", "test image", 1, []string{"animal", "animalz"}, true)
+
+	var unknownErr *services.UnknownCategoriesError
+	assert.ErrorAs(t, err, &unknownErr)
+	assert.Equal(t, []string{"animalz"}, unknownErr.Names)
+	assert.Nil(t, uploadedImage)
+}
+
+// lenient 모드에서 대소문자와 별칭으로 카테고리를 찾고, 찾지 못한 이름은 경고로 반환하는지 테스트
+func TestUploadImageLenientCategoryAlias(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	animal := models.Category{ID: 3, Name: "ANIMAL"}
+	mockCategoryRepo.EXPECT().GetCategoriesByName([]string{"ANIMAL", "ANIMALS", "ANIMALZ"}).Return([]models.Category{animal}, nil)
+	mockCategoryRepo.EXPECT().GetCategoryAliases([]string{"ANIMALS", "ANIMALZ"}).
+		Return([]models.CategoryAlias{{Alias: "ANIMALS", CategoryID: animal.ID, Category: animal}}, nil)
+	// 별칭이 같은 카테고리를 가리키므로 한 번만 매핑
+	mockImageRepo.EXPECT().CreateImage(gomock.Any(), []uint{animal.ID}).Return(nil)
+
+	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo, storage.NewLocalStorage(), testConfig.Storage, testConfig.Image, nil)
+
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(nil)
+	ctx.Request = newImageUploadRequest(t, "test.jpg")
+
+	saveDir := "./uploads/1"
+	defer os.RemoveAll(saveDir)
+
+	uploadedImage, unknownNames, err := imageService.UploadImage(ctx, "test.jpg", "test image", 1, []string{"Animal", "animals", "animalz", "ANIMAL"}, false)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, uploadedImage)
+	assert.Equal(t, []string{"animalz"}, unknownNames)
+}
+
+// 여러 파일 업로드 시 파일별로 성공, 실패 결과를 요청 순서대로 반환하는지 테스트
+func TestUploadImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer os.RemoveAll("./uploads/1")
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	mockImageRepo.EXPECT().CreateImage(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo, storage.NewLocalStorage(), testConfig.Storage, testConfig.Image, nil)
+
+	var imgBuf bytes.Buffer
+	if err := jpeg.Encode(&imgBuf, image.NewRGBA(image.Rect(0, 0, 100, 100)), nil); err != nil {
+		t.Fatalf("이미지 인코딩에 실패했습니다: %v", err)
+	}
+	newFile := func(fileName string, data []byte) services.BatchUploadFile {
+		return services.BatchUploadFile{
+			FileName:    fileName,
+			Description: fileName + " description",
+			Open: func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(data)), nil
+			},
+		}
+	}
+
+	results, err := imageService.UploadImages([]services.BatchUploadFile{
+		newFile("a.jpg", imgBuf.Bytes()),
+		newFile("broken.jpg", []byte("not an image")),
+		newFile("b.jpg", imgBuf.Bytes()),
+		newFile("a.jpg", imgBuf.Bytes()),
+	}, 1, false)
+
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+	assert.True(t, results[0].Success)
+	assert.Equal(t, "a.jpg description", results[0].Image.Description)
+	assert.False(t, results[1].Success)
+	assert.Contains(t, results[1].Error, "디코딩")
+	assert.True(t, results[2].Success)
+	// 같은 이름의 파일도 서로 다른 경로에 저장되어 덮어쓰지 않음
+	if assert.True(t, results[3].Success) {
+		assert.Equal(t, "a.jpg", results[3].Image.FileName)
+		assert.NotEqual(t, results[0].Image.FilePath, results[3].Image.FilePath)
+		assert.NotEqual(t, results[0].Image.ThumbnailPath, results[3].Image.ThumbnailPath)
+	}
+
+	_, err = imageService.UploadImages(nil, 1, false)
+	assert.ErrorIs(t, err, services.ErrInvalidBatchUpload)
+}
+
+// 원본 해시가 저장되지 않은 이미지는 한 번만 계산해서 저장하고, 저장된 해시는 파일을 읽지 않고 사용하는지 테스트
+func TestGetContentHash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	filePath := filepath.Join(t.TempDir(), "legacy.jpg")
+	assert.NoError(t, os.WriteFile(filePath, []byte("legacy"), 0o644))
+
+	const storedHash = "stored-content-hash"
+	var stored string
+	mockImageRepo.EXPECT().UpdateImageContentHash(uint(1), gomock.Any()).DoAndReturn(func(imageID uint, contentHash string) error {
+		stored = contentHash
+		return nil
+	}).Times(1)
+
+	imageService := services.NewImageService(mockImageRepo, mocks.NewMockCategoryRepository(ctrl), mocks.NewMockImageCategoryRepository(ctrl),
+		mocks.NewMockPermissionRepository(ctrl), storage.NewLocalStorage(), testConfig.Storage, testConfig.Image, nil)
+
+	image := &models.Image{ID: 1, FilePath: filePath}
+	hash, err := imageService.GetContentHash(image)
+	assert.NoError(t, err)
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, stored)
+	assert.Equal(t, hash, image.ContentHash)
+
+	// 저장된 해시가 있으면 파일이 없어도 그대로 사용
+	hash, err = imageService.GetContentHash(&models.Image{ID: 2, FilePath: "missing.jpg", ContentHash: storedHash})
+	assert.NoError(t, err)
+	assert.Equal(t, storedHash, hash)
+
+	_, err = imageService.GetContentHash(&models.Image{ID: 3, FilePath: filepath.Join(t.TempDir(), "missing.jpg")})
+	assert.Error(t, err)
+}
+
+// 테스트용 이미지가 담긴 멀티파트 요청 생성
+func newImageUploadRequest(t *testing.T, fileName string) *http.Request {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	var imgBuf bytes.Buffer
+	if err := jpeg.Encode(&imgBuf, img, nil); err != nil {
+		t.Fatalf("이미지 인코딩에 실패했습니다: %v", err)
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, err := writer.CreateFormFile("image", fileName)
+	if err != nil {
+		t.Fatalf("form file을 만드는데 실패했습니다: %v", err)
+	}
+	if _, err := part.Write(imgBuf.Bytes()); err != nil {
+		t.Fatalf("form file에 작성하는데 실패했습니다: %v", err)
+	}
+	writer.Close()
+
+	req := &http.Request{
+		Header: make(http.Header),
+		Body:   io.NopCloser(&buf),
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
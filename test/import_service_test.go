@@ -0,0 +1,93 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"image"
+	"image/jpeg"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"testing"
+)
+
+// 로컬 테스트 서버의 이미지를 가져오는 흐름 테스트
+// 테스트 서버는 127.0.0.1에서 실행되므로 allow-list에 추가해야만 가져올 수 있음
+func TestImportImageFromURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer os.RemoveAll("./uploads/1")
+
+	var imgBuf bytes.Buffer
+	if err := jpeg.Encode(&imgBuf, image.NewRGBA(image.Rect(0, 0, 100, 100)), nil); err != nil {
+		t.Fatalf("이미지 인코딩에 실패했습니다: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cat", "/", "/cat.php":
+			_, _ = w.Write(imgBuf.Bytes())
+		case "/large.jpg":
+			_, _ = w.Write(make([]byte, 21<<20))
+		case "/page.jpg":
+			_, _ = w.Write([]byte("<html><body>not an image</body></html>"))
+		case "/redirect":
+			http.Redirect(w, r, "/cat", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockImageRepo.EXPECT().CreateImage(gomock.Any(), gomock.Any()).Return(nil).Times(5)
+	imageService := services.NewImageService(mockImageRepo, mocks.NewMockCategoryRepository(ctrl), mocks.NewMockImageCategoryRepository(ctrl),
+		mocks.NewMockPermissionRepository(ctrl), storage.NewLocalStorage(), testConfig.Storage, testConfig.Image, nil)
+
+	// allow-list가 없으면 루프백 주소는 차단
+	blocked := services.NewImportService(imageService, nil)
+	_, _, err := blocked.ImportImageFromURL(context.Background(), server.URL+"/cat", "", 1, nil, false)
+	assert.ErrorIs(t, err, services.ErrImportBlockedHost)
+	_, _, err = blocked.ImportImageFromURL(context.Background(), "http://[::ffff:169.254.169.254]/latest/meta-data", "", 1, nil, false)
+	assert.ErrorIs(t, err, services.ErrImportBlockedHost)
+	_, _, err = blocked.ImportImageFromURL(context.Background(), "file:///etc/passwd", "", 1, nil, false)
+	assert.ErrorIs(t, err, services.ErrInvalidImportURL)
+
+	importService := services.NewImportService(imageService, []netip.Prefix{netip.MustParsePrefix("127.0.0.1/32")})
+
+	var imported *models.Image
+	imported, _, err = importService.ImportImageFromURL(context.Background(), server.URL+"/cat", "imported", 1, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "cat.jpg", imported.FileName)
+	assert.Equal(t, "imported", imported.Description)
+
+	_, _, err = importService.ImportImageFromURL(context.Background(), server.URL+"/redirect", "", 1, nil, false)
+	assert.NoError(t, err)
+
+	// 확장자가 내용과 다르면 내용에 맞는 확장자를 붙이고, 경로에 파일명이 없으면 가져올 때마다 다른 이름을 만듦
+	imported, _, err = importService.ImportImageFromURL(context.Background(), server.URL+"/cat.php", "", 1, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "cat.php.jpg", imported.FileName)
+	first, _, err := importService.ImportImageFromURL(context.Background(), server.URL+"/", "", 1, nil, false)
+	assert.NoError(t, err)
+	second, _, err := importService.ImportImageFromURL(context.Background(), server.URL+"/", "", 1, nil, false)
+	assert.NoError(t, err)
+	assert.Regexp(t, `^image_\d{14}_[0-9a-f]{8}\.jpg$`, first.FileName)
+	assert.NotEqual(t, first.FileName, second.FileName)
+	assert.NotEqual(t, first.FilePath, second.FilePath)
+
+	_, _, err = importService.ImportImageFromURL(context.Background(), server.URL+"/large.jpg", "", 1, nil, false)
+	assert.ErrorIs(t, err, services.ErrImportTooLarge)
+
+	_, _, err = importService.ImportImageFromURL(context.Background(), server.URL+"/page.jpg", "", 1, nil, false)
+	assert.ErrorIs(t, err, services.ErrImportNotImage)
+
+	_, _, err = importService.ImportImageFromURL(context.Background(), server.URL+"/missing.jpg", "", 1, nil, false)
+	assert.ErrorIs(t, err, services.ErrImportFailed)
+}
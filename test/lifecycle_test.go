@@ -0,0 +1,120 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/lifecycle"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// 종료할 때 ctx를 취소하고, ctx와 상관없이 도는 작업과 주기적인 작업이 끝날 때까지 기다리는지 테스트
+func TestRegistryShutdownWaitsForTasks(t *testing.T) {
+	registry := lifecycle.NewRegistry()
+
+	var finished, ticks atomic.Int32
+	registry.Go("느린 작업", func(context.Context) {
+		time.Sleep(100 * time.Millisecond)
+		finished.Add(1)
+	})
+	cancelled := make(chan struct{})
+	registry.Go("취소되는 작업", func(ctx context.Context) {
+		<-ctx.Done()
+		close(cancelled)
+	})
+	registry.Every("주기적인 작업", 10*time.Millisecond, func(context.Context) {
+		ticks.Add(1)
+	})
+	time.Sleep(50 * time.Millisecond)
+
+	assert.NoError(t, registry.Shutdown(context.Background()))
+	assert.Equal(t, int32(1), finished.Load())
+	assert.Positive(t, ticks.Load())
+	<-cancelled
+
+	// 종료가 시작된 뒤 등록한 작업은 바로 실행하고, 주기적인 작업은 더 실행되지 않음
+	stopped := ticks.Load()
+	registry.Go("늦게 등록한 작업", func(context.Context) {
+		finished.Add(1)
+	})
+	assert.Equal(t, int32(2), finished.Load())
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, stopped, ticks.Load())
+}
+
+// 종료 대기 시간 안에 끝나지 않은 작업이 있으면 작업 이름과 함께 에러를 반환하는지 테스트
+func TestRegistryShutdownTimeout(t *testing.T) {
+	registry := lifecycle.NewRegistry()
+	release := make(chan struct{})
+	defer close(release)
+	registry.Go("멈춘 작업", func(context.Context) {
+		<-release
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := registry.Shutdown(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "멈춘 작업 x1")
+}
+
+// 응답 후에 이어지는 사용자 이미지 파일 삭제를 종료할 때 끝까지 기다리는지 테스트
+func TestDeleteAllImagesFinishesOnShutdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	dir := t.TempDir()
+	images := []models.Image{
+		{ID: 1, FilePath: filepath.Join(dir, "1.jpg"), ThumbnailPath: filepath.Join(dir, "thumb_1.jpg")},
+		{ID: 2, FilePath: filepath.Join(dir, "2.jpg"), ThumbnailPath: filepath.Join(dir, "thumb_2.jpg")},
+	}
+	assert.NoError(t, createTestFiles(images))
+	mockImageRepo.EXPECT().GetImagesByUserID(uint(1)).Return(images, nil)
+	mockImageRepo.EXPECT().DeleteImagesByUserID(uint(1)).Return(nil)
+
+	registry := lifecycle.NewRegistry()
+	imageService := services.NewImageService(mockImageRepo, nil, nil, nil, storage.NewLocalStorage(), testConfig.Storage, testConfig.Image, registry)
+	assert.NoError(t, imageService.DeleteAllImagesByUserID(1))
+	assert.NoError(t, registry.Shutdown(context.Background()))
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+// 파일을 쓰는 도중 실패하면 기존 파일은 그대로 두고 덜 쓰인 파일을 남기지 않는지 테스트
+func TestLocalStorageSaveFailure(t *testing.T) {
+	fileStorage := storage.NewLocalStorage()
+	path := filepath.Join(t.TempDir(), "image.jpg")
+	_, err := fileStorage.Save(path, bytes.NewReader([]byte("original")))
+	assert.NoError(t, err)
+
+	_, err = fileStorage.Save(path, io.MultiReader(bytes.NewReader([]byte("partial")), failingReader{}))
+	assert.Error(t, err)
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "original", string(data))
+	entries, err := os.ReadDir(filepath.Dir(path))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+// failingReader 연결이 끊긴 요청 본문처럼 읽기에 실패하는 reader
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("연결이 끊겼습니다")
+}
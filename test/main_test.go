@@ -0,0 +1,19 @@
+package test
+
+import (
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"time"
+)
+
+// testConfig 테스트에서 서비스를 만들 때 사용하는 기본 설정, 업로드 파일은 test/uploads에 저장
+var testConfig = func() *config.Config {
+	cfg := config.Default()
+	cfg.Auth.JWTSecret = "image-hub-test-jwt-secret"
+	cfg.Auth.TokenTTL = config.Duration(time.Hour)
+	cfg.Auth.URLSigningKey = "image-hub-test-url-signing-key"
+	return cfg
+}()
+
+// testSigner 테스트에서 서비스를 만들 때 사용하는 파일 URL 서명기
+var testSigner = signedurl.NewSigner(testConfig.Auth.URLSigningKey, testConfig.Storage.UploadsDir)
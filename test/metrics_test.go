@@ -0,0 +1,39 @@
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+// 이미지를 업로드하고 삭제한 뒤 /metrics에 HTTP, 업로드, 썸네일, 저장소, DB 메트릭이 기록되는지 테스트
+// 메트릭은 테스트끼리 함께 쓰므로 값 대신 시계열이 있는지만 확인
+func TestHTTPMetrics(t *testing.T) {
+	server := newTestServer(t)
+	_, token := server.signUp("user@example.com", "user-password")
+	uploaded := server.uploadImage("/api/user/images", token, "cat.jpg")
+	assertStatus(t, http.StatusOK, server.do(http.MethodGet, imagePath("/api/user/images", uploaded.ID, "/"), token, nil, ""))
+	assertStatus(t, http.StatusNotFound, server.do(http.MethodGet, "/no-such-path/123", "", nil, ""))
+	assertStatus(t, http.StatusOK, server.do(http.MethodDelete, "/api/user/images", token, nil, ""))
+
+	w := server.do(http.MethodGet, "/metrics", "", nil, "")
+	assertStatus(t, http.StatusOK, w)
+	body := w.Body.String()
+	for _, series := range []string{
+		`image_hub_http_requests_total{method="POST",route="/api/user/images",status="200"}`,
+		`image_hub_http_requests_total{method="GET",route="/api/user/images/:imageID/",status="200"}`,
+		`image_hub_http_requests_total{method="OTHER",route="unmatched",status="404"}`,
+		`image_hub_http_request_duration_seconds_count{method="POST",route="/api/user/images"}`,
+		`image_hub_uploads_total{result="success"}`,
+		`image_hub_upload_bytes_total`,
+		`image_hub_thumbnail_duration_seconds_count`,
+		`image_hub_thumbnail_failures_total`,
+		`image_hub_storage_operation_duration_seconds_count{operation="save",result="ok"}`,
+		`image_hub_db_query_duration_seconds_count{operation="create",table="images"}`,
+		`image_hub_db_query_duration_seconds_count{operation="query",table="users"}`,
+		`image_hub_image_file_deletions_active`,
+	} {
+		assert.Contains(t, body, series)
+	}
+	assert.NotContains(t, body, "/no-such-path")
+}
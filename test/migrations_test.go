@@ -0,0 +1,171 @@
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/initializers"
+	"github.com/zeze1004/image-hub-platform/migrations"
+	"github.com/zeze1004/image-hub-platform/models"
+	"testing"
+)
+
+// 마이그레이션을 번호 순서대로 적용하고 기록하며, 마지막 것부터 하나씩 되돌리는지 테스트
+func TestMigrateUpDown(t *testing.T) {
+	db, err := initializers.OpenDB(config.DatabaseConfig{Driver: config.DriverSQLite, Path: ":memory:"})
+	assert.NoError(t, err)
+
+	admin := migrations.AdminAccount{Email: "admin@example.com", Password: "admin-password"}
+	migrator := migrations.NewMigrator(db, append(migrations.Schema(), migrations.Seeds(admin)...))
+
+	applied, err := migrator.Up()
+	assert.NoError(t, err)
+	assert.Len(t, applied, 5)
+	pending, err := migrator.Pending()
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+
+	var categories []models.Category
+	assert.NoError(t, db.Order("id").Find(&categories).Error)
+	assert.Len(t, categories, 5)
+	var aliases []models.CategoryAlias
+	assert.NoError(t, db.Where("alias = ?", "PETS").Find(&aliases).Error)
+	assert.Equal(t, categories[2].ID, aliases[0].CategoryID)
+	var user models.User
+	assert.NoError(t, db.Where("email = ?", admin.Email).First(&user).Error)
+	assert.Equal(t, "ADMIN", user.Role)
+
+	// 다시 적용해도 아무것도 바뀌지 않음
+	applied, err = migrator.Up()
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+
+	// 관리자 계정 시드 데이터는 원래 있던 계정일 수 있으므로 되돌리지 않음
+	reverted, err := migrator.Down()
+	assert.ErrorIs(t, err, migrations.ErrIrreversible)
+	assert.Nil(t, reverted)
+	assert.NoError(t, db.Where("email = ?", admin.Email).First(&models.User{}).Error)
+
+	statuses, err := migrator.Status()
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 5)
+	assert.NotNil(t, statuses[0].AppliedAt)
+	assert.NotNil(t, statuses[4].AppliedAt)
+
+	// 스키마 마이그레이션만 다루는 Migrator는 시드 데이터를 건너뛰고 스키마를 되돌리며,
+	// 예전 MySQL 스키마를 바로잡는 0002는 되돌릴 수 없음
+	schemaMigrator := migrations.NewMigrator(db, migrations.Schema())
+	reverted, err = schemaMigrator.Down()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), reverted.Version)
+	assert.False(t, db.Migrator().HasTable("bulk_import_jobs"))
+	reverted, err = schemaMigrator.Down()
+	assert.ErrorIs(t, err, migrations.ErrIrreversible)
+	assert.Nil(t, reverted)
+	assert.True(t, db.Migrator().HasTable("images"))
+}
+
+// 관리자 비밀번호 없이 관리자 계정 시드 데이터를 적용하면 실패하고 기록하지 않는지 테스트
+func TestMigrateSeedAdminRequiresPassword(t *testing.T) {
+	db, err := initializers.OpenDB(config.DatabaseConfig{Driver: config.DriverSQLite, Path: ":memory:"})
+	assert.NoError(t, err)
+
+	migrator := migrations.NewMigrator(db, append(migrations.Schema(), migrations.Seeds(migrations.AdminAccount{Email: "admin@example.com"})...))
+	applied, err := migrator.Up()
+	assert.Error(t, err)
+	assert.Len(t, applied, 4)
+
+	pending, err := migrator.Pending()
+	assert.NoError(t, err)
+	assert.Len(t, pending, 1)
+	assert.Equal(t, "seed_admin", pending[0].Name)
+}
+
+// 예전 scripts/database.sql로 만든 DB에 마이그레이션을 적용하면 원래 있던 데이터는 그대로 두고 빠진 테이블만 만들며,
+// 0001은 원래 있던 테이블까지 지울 수 있으므로 되돌리지 않는지 테스트
+// SQLite에서는 MySQL의 컬럼 타입을 바꾸는 부분은 실행하지 않으므로, database.sql의 테이블과 데이터를 SQLite 문법으로 옮겨서 확인
+func TestMigrateLegacySchema(t *testing.T) {
+	db, err := initializers.OpenDB(config.DatabaseConfig{Driver: config.DriverSQLite, Path: ":memory:"})
+	assert.NoError(t, err)
+	for _, statement := range []string{
+		`CREATE TABLE users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			email VARCHAR(30) NULL,
+			password VARCHAR(255) NOT NULL,
+			role VARCHAR(10) DEFAULT 'USER' NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NULL,
+			deleted_at DATETIME NULL
+		)`,
+		`INSERT INTO users (email, password, role, created_at) VALUES
+			('admin@example.com', 'hashed', 'ADMIN', CURRENT_TIMESTAMP),
+			('user1@example.com', 'hashed', 'USER', CURRENT_TIMESTAMP)`,
+		`CREATE TABLE categories (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name VARCHAR(255) NOT NULL,
+			created_at TIMESTAMP NULL,
+			updated_at TIMESTAMP NULL,
+			deleted_at TIMESTAMP NULL,
+			CONSTRAINT unique_name UNIQUE (name)
+		)`,
+		`INSERT INTO categories (name) VALUES ('PERSON'), ('LANDSCAPE'), ('ANIMAL'), ('FOOD'), ('OTHERS')`,
+		`CREATE TABLE images (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			file_name VARCHAR(255) NOT NULL,
+			file_path VARCHAR(255) NOT NULL,
+			upload_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP NULL,
+			description TEXT NULL,
+			user_id INT NULL,
+			created_at TIMESTAMP NULL,
+			updated_at TIMESTAMP NULL,
+			deleted_at TIMESTAMP NULL,
+			thumbnail_path VARCHAR(255) NOT NULL
+		)`,
+		`INSERT INTO images (file_name, file_path, description, user_id, thumbnail_path) VALUES
+			('image1.jpg', 'uploads/1/image1.jpg', 'Description for image1', 2, 'uploads/1/thumb_image1.jpg')`,
+		`CREATE TABLE image_categories (
+			image_id INT NOT NULL,
+			category_id INT NOT NULL,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL
+		)`,
+		`INSERT INTO image_categories (image_id, category_id) VALUES (1, 1), (1, 2)`,
+	} {
+		require.NoError(t, db.Exec(statement).Error)
+	}
+
+	admin := migrations.AdminAccount{Email: "admin@example.com", Password: "admin-password"}
+	migrator := migrations.NewMigrator(db, append(migrations.Schema(), migrations.Seeds(admin)...))
+	applied, err := migrator.Up()
+	assert.NoError(t, err)
+	assert.Len(t, applied, 5)
+
+	var users []models.User
+	assert.NoError(t, db.Order("id").Find(&users).Error)
+	if assert.Len(t, users, 2) {
+		assert.Equal(t, "hashed", users[0].Password)
+	}
+	var categoryCount, imageCategoryCount int64
+	assert.NoError(t, db.Model(&models.Category{}).Count(&categoryCount).Error)
+	assert.Equal(t, int64(5), categoryCount)
+	assert.NoError(t, db.Model(&models.ImageCategory{}).Count(&imageCategoryCount).Error)
+	assert.Equal(t, int64(2), imageCategoryCount)
+	var image models.Image
+	assert.NoError(t, db.First(&image).Error)
+	assert.Equal(t, users[1].ID, image.UserID)
+
+	// 원래 없던 테이블은 users.id를 가리키는 외래키와 함께 만듦
+	for _, table := range []string{"tags", "albums", "image_permissions", "export_jobs", "bulk_import_jobs"} {
+		assert.True(t, db.Migrator().HasTable(table), table)
+	}
+	assert.True(t, db.Migrator().HasConstraint("image_permissions", "fk_image_permissions_user"))
+	assert.True(t, db.Migrator().HasConstraint("export_jobs", "fk_export_jobs_user"))
+	assert.NoError(t, db.Create(&models.ImagePermission{ImageID: image.ID, UserID: users[0].ID, Role: "VIEWER", GrantedBy: users[1].ID}).Error)
+
+	initialMigrator := migrations.NewMigrator(db, migrations.Schema()[:1])
+	reverted, err := initialMigrator.Down()
+	assert.ErrorIs(t, err, migrations.ErrIrreversible)
+	assert.Nil(t, reverted)
+	assert.True(t, db.Migrator().HasTable("users"))
+	assert.True(t, db.Migrator().HasTable("images"))
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"testing"
+)
+
+// 소유자가 이메일로 다른 사용자에게 권한을 부여하고, 잘못된 역할은 거절하는지 테스트
+func TestGrantPermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockUserRepo := mocks.NewMockUserRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	mockImageRepo.EXPECT().GetImageByID(uint(1)).Return(&models.Image{ID: 1, UserID: 1}, nil).AnyTimes()
+	mockUserRepo.EXPECT().GetUserByEmail("friend@example.com").Return(&models.User{ID: 2}, nil)
+	mockPermissionRepo.EXPECT().GrantPermission(gomock.Any()).Return(nil)
+
+	permissionService := services.NewPermissionService(mockImageRepo, mockUserRepo, mockPermissionRepo)
+
+	permission, err := permissionService.GrantPermission(1, 1, false, "friend@example.com", "viewer")
+	assert.NoError(t, err)
+	assert.Equal(t, uint(2), permission.UserID)
+	assert.Equal(t, models.ImageRoleViewer, permission.Role)
+
+	_, err = permissionService.GrantPermission(1, 1, false, "friend@example.com", "OWNER")
+	assert.True(t, errors.Is(err, services.ErrInvalidPermissionRequest))
+
+	// 소유자가 아니면 권한을 부여할 수 없음
+	_, err = permissionService.GrantPermission(1, 2, false, "friend@example.com", "EDITOR")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "권한이 없습니다")
+}
+
+// VIEWER는 카테고리 조회만, EDITOR는 카테고리 수정까지 가능한지 테스트
+func TestCategoryPermissionByRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	mockImageRepo.EXPECT().GetImageByID(uint(1)).Return(&models.Image{ID: 1, UserID: 1}, nil).AnyTimes()
+	mockPermissionRepo.EXPECT().GetPermissionRole(uint(1), uint(2)).Return(models.ImageRoleViewer, nil).AnyTimes()
+	mockPermissionRepo.EXPECT().GetPermissionRole(uint(1), uint(3)).Return(models.ImageRoleEditor, nil).AnyTimes()
+	mockCategoryRepo.EXPECT().GetCategoriesByImageID(uint(1)).Return([]models.Category{{ID: 1}}, nil)
+	mockImageCategoryRepo.EXPECT().Transaction(gomock.Any()).DoAndReturn(
+		func(fn func(txRepo repositories.ImageCategoryRepository) error) error {
+			return fn(mockImageCategoryRepo)
+		})
+	mockImageCategoryRepo.EXPECT().LockImage(uint(1)).Return(nil)
+	mockImageCategoryRepo.EXPECT().GetCategoriesByImageID(uint(1)).Return([]models.Category{{ID: 1}}, nil)
+	mockImageCategoryRepo.EXPECT().AddCategoryToImage(uint(1), uint(2)).Return(nil)
+
+	categoryService := services.NewCategoryService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo)
+
+	categories, err := categoryService.GetCategoriesByImageIDAndUserID(1, 2, false)
+	assert.NoError(t, err)
+	assert.Len(t, categories, 1)
+
+	err = categoryService.AddCategoryToImageByImageIDAndCategoryID(1, 2, 2, false)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "권한이 없습니다")
+
+	err = categoryService.AddCategoryToImageByImageIDAndCategoryID(1, 2, 3, false)
+	assert.NoError(t, err)
+}
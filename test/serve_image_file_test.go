@@ -0,0 +1,110 @@
+package test
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"github.com/zeze1004/image-hub-platform/utils"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// ETag, Last-Modified 조건부 요청과 Range 요청 처리 테스트
+func TestServeImageFile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	filePath := filepath.Join(t.TempDir(), "test.jpg")
+	assert.NoError(t, os.WriteFile(filePath, []byte("0123456789"), 0o644))
+	updatedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	router := gin.New()
+	router.GET("/file", func(ctx *gin.Context) {
+		utils.ServeImageFile(ctx, storage.NewLocalStorage(), filePath, "abc123", updatedAt, utils.CacheControlOriginal, ctx.Query("name"))
+	})
+	serve := func(header http.Header, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/file"+query, nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := serve(nil, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"abc123"`, w.Header().Get("ETag"))
+	assert.Equal(t, updatedAt.Format(http.TimeFormat), w.Header().Get("Last-Modified"))
+	assert.Equal(t, utils.CacheControlOriginal, w.Header().Get("Cache-Control"))
+	assert.Equal(t, "image/jpeg", w.Header().Get("Content-Type"))
+
+	w = serve(http.Header{"If-None-Match": {`"abc123"`}}, "")
+	assert.Equal(t, http.StatusNotModified, w.Code)
+
+	w = serve(http.Header{"If-Modified-Since": {updatedAt.Format(http.TimeFormat)}}, "")
+	assert.Equal(t, http.StatusNotModified, w.Code)
+
+	w = serve(http.Header{"Range": {"bytes=2-5"}}, "")
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, "2345", w.Body.String())
+	assert.Equal(t, "bytes 2-5/10", w.Header().Get("Content-Range"))
+
+	w = serve(nil, "?name=고양이.jpg")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment")
+}
+
+// streamStorage Open이 탐색할 수 없는 스트림을 반환하는 저장소 (원격 저장소 흉내)
+type streamStorage struct {
+	storage.Storage
+}
+
+func (s streamStorage) Open(path string) (io.ReadCloser, error) {
+	file, err := s.Storage.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{file, file}, nil
+}
+
+// 탐색할 수 없는 저장소 파일도 Size로 Content-Length와 Range 요청을 처리하는지 테스트
+func TestServeImageFileFromStream(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	filePath := filepath.Join(t.TempDir(), "test.jpg")
+	assert.NoError(t, os.WriteFile(filePath, []byte("0123456789"), 0o644))
+
+	router := gin.New()
+	router.GET("/file", func(ctx *gin.Context) {
+		utils.ServeImageFile(ctx, streamStorage{storage.NewLocalStorage()}, filePath, "", time.Time{}, utils.CacheControlOriginal, "")
+	})
+	router.GET("/missing", func(ctx *gin.Context) {
+		utils.ServeImageFile(ctx, streamStorage{storage.NewLocalStorage()}, filepath.Dir(filePath), "", time.Time{}, utils.CacheControlOriginal, "")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/file", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "10", w.Header().Get("Content-Length"))
+	assert.Equal(t, "0123456789", w.Body.String())
+
+	req := httptest.NewRequest(http.MethodGet, "/file", nil)
+	req.Header.Set("Range", "bytes=4-6")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, "456", w.Body.String())
+
+	// 디렉터리는 파일이 아니므로 404
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/missing", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
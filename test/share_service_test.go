@@ -0,0 +1,108 @@
+package test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/services"
+	"testing"
+	"time"
+)
+
+// 비밀번호가 걸린 공유 링크를 생성하고 토큰으로 열 수 있는지 테스트
+func TestCreateAndOpenShare(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockShareRepo := mocks.NewMockShareRepository(ctrl)
+
+	image := &models.Image{ID: 1, UserID: 1, FilePath: "uploads/1/test.jpg"}
+	mockImageRepo.EXPECT().GetImageByID(uint(1)).Return(image, nil).AnyTimes()
+
+	var saved models.ImageShare
+	mockShareRepo.EXPECT().CreateShare(gomock.Any()).DoAndReturn(func(share *models.ImageShare) error {
+		share.ID = 7
+		saved = *share
+		return nil
+	})
+	mockShareRepo.EXPECT().GetShareByTokenHash(gomock.Any()).DoAndReturn(func(tokenHash string) (*models.ImageShare, error) {
+		if tokenHash != saved.TokenHash {
+			return nil, errors.New("record not found")
+		}
+		share := saved
+		return &share, nil
+	}).AnyTimes()
+	mockShareRepo.EXPECT().IncrementViewCount(uint(7)).Return(true, nil)
+
+	shareService := services.NewShareService(mockImageRepo, mockShareRepo)
+
+	expiresAt := time.Now().Add(time.Hour)
+	share, token, err := shareService.CreateShare(1, 1, false, services.ShareOptions{ExpiresAt: &expiresAt, Password: "secret", MaxViews: 3})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.True(t, share.HasPassword)
+	assert.NotEqual(t, token, saved.TokenHash) // 토큰 원문은 저장하지 않음
+
+	_, err = shareService.OpenShare(token, "wrong")
+	assert.True(t, errors.Is(err, services.ErrSharePasswordRequired))
+
+	_, err = shareService.OpenShare("unknown-token", "secret")
+	assert.True(t, errors.Is(err, services.ErrShareNotFound))
+
+	opened, err := shareService.OpenShare(token, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, image.FilePath, opened.FilePath)
+}
+
+// 만료, 폐기, 조회 수 초과된 공유 링크는 열 수 없는지 테스트
+func TestOpenShareUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockShareRepo := mocks.NewMockShareRepository(ctrl)
+
+	past := time.Now().Add(-time.Minute)
+	mockShareRepo.EXPECT().GetShareByTokenHash(gomock.Any()).Return(&models.ImageShare{ID: 1, ImageID: 1, ExpiresAt: &past}, nil)
+	mockShareRepo.EXPECT().GetShareByTokenHash(gomock.Any()).Return(&models.ImageShare{ID: 2, ImageID: 1, RevokedAt: &past}, nil)
+	mockShareRepo.EXPECT().GetShareByTokenHash(gomock.Any()).Return(&models.ImageShare{ID: 3, ImageID: 1, MaxViews: 1, ViewCount: 1}, nil)
+	mockImageRepo.EXPECT().GetImageByID(uint(1)).Return(&models.Image{ID: 1}, nil)
+	mockShareRepo.EXPECT().IncrementViewCount(uint(3)).Return(false, nil)
+
+	shareService := services.NewShareService(mockImageRepo, mockShareRepo)
+
+	_, err := shareService.OpenShare("expired", "")
+	assert.True(t, errors.Is(err, services.ErrShareExpired))
+
+	_, err = shareService.OpenShare("revoked", "")
+	assert.True(t, errors.Is(err, services.ErrShareNotFound))
+
+	_, err = shareService.OpenShare("exhausted", "")
+	assert.True(t, errors.Is(err, services.ErrShareExpired))
+}
+
+// 미리보기는 조회 수를 올리지 않고, 조회 수 제한을 다 쓴 링크는 미리보기도 열 수 없는지 테스트
+func TestOpenShareThumbnail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockShareRepo := mocks.NewMockShareRepository(ctrl)
+
+	mockShareRepo.EXPECT().GetShareByTokenHash(gomock.Any()).Return(&models.ImageShare{ID: 1, ImageID: 1, MaxViews: 2, ViewCount: 1}, nil)
+	mockShareRepo.EXPECT().GetShareByTokenHash(gomock.Any()).Return(&models.ImageShare{ID: 2, ImageID: 1, MaxViews: 2, ViewCount: 2}, nil)
+	mockImageRepo.EXPECT().GetImageByID(uint(1)).Return(&models.Image{ID: 1, ThumbnailPath: "uploads/1/thumb_test.jpg"}, nil).Times(2)
+	mockShareRepo.EXPECT().IncrementViewCount(gomock.Any()).Times(0)
+
+	shareService := services.NewShareService(mockImageRepo, mockShareRepo)
+
+	image, err := shareService.OpenShareThumbnail("remaining", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "uploads/1/thumb_test.jpg", image.ThumbnailPath)
+
+	_, err = shareService.OpenShareThumbnail("exhausted", "")
+	assert.True(t, errors.Is(err, services.ErrShareExpired))
+}
@@ -0,0 +1,46 @@
+package test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// 서명된 URL이 검증되고, 경로나 만료 시각을 바꾸면 거절되는지 테스트
+func TestSignedURL(t *testing.T) {
+	signed := testSigner.Sign("./uploads/1/cat photo.jpg")
+	u, err := url.Parse(signed)
+	assert.NoError(t, err)
+	assert.Equal(t, "/files/1/cat photo.jpg", u.Path)
+
+	expires, sig := u.Query().Get("expires"), u.Query().Get("signature")
+	_, err = testSigner.Verify(u.Path, expires, sig)
+	assert.NoError(t, err)
+
+	storagePath, err := testSigner.StoragePath(u.Path)
+	assert.NoError(t, err)
+	assert.Equal(t, "uploads/1/cat photo.jpg", storagePath)
+
+	// 다른 사용자의 파일 경로나 만료 시각을 바꾸면 서명 검증 실패
+	_, err = testSigner.Verify("/files/2/cat photo.jpg", expires, sig)
+	assert.True(t, errors.Is(err, signedurl.ErrInvalidSignature))
+	_, err = testSigner.Verify(u.Path, "9999999999", sig)
+	assert.True(t, errors.Is(err, signedurl.ErrInvalidSignature))
+}
+
+// 만료된 URL과 업로드 디렉토리 밖의 경로 처리 테스트
+func TestSignedURLExpiredAndOutsideUploads(t *testing.T) {
+	signed := testSigner.SignWithExpiry("uploads/1/cat.jpg", time.Now().Add(-time.Minute))
+	u, _ := url.Parse(signed)
+	_, err := testSigner.Verify(u.Path, u.Query().Get("expires"), u.Query().Get("signature"))
+	assert.True(t, errors.Is(err, signedurl.ErrExpired))
+
+	assert.Empty(t, testSigner.Sign("/etc/passwd"))
+	assert.Empty(t, testSigner.Sign(""))
+
+	_, err = testSigner.StoragePath("/files/../main.go")
+	assert.Error(t, err)
+}
@@ -0,0 +1,43 @@
+package test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/config"
+	"github.com/zeze1004/image-hub-platform/initializers"
+	"github.com/zeze1004/image-hub-platform/migrations"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"testing"
+	"time"
+)
+
+// MySQL 전용 SQL 없이 SQLite에서도 마이그레이션으로 테이블을 만들고 카테고리 매핑과 일별 업로드 통계가 동작하는지 테스트
+func TestSQLiteRepositories(t *testing.T) {
+	db, err := initializers.OpenDB(config.DatabaseConfig{Driver: config.DriverSQLite, Path: ":memory:"})
+	assert.NoError(t, err)
+	_, err = migrations.NewMigrator(db, migrations.Schema()).Up()
+	assert.NoError(t, err)
+
+	user := models.User{Email: "user@example.com", Password: "hashed"}
+	assert.NoError(t, db.Create(&user).Error)
+	assert.Equal(t, "USER", user.Role)
+	assert.Error(t, db.Create(&models.User{Email: "bad@example.com", Password: "hashed", Role: "ROOT"}).Error)
+
+	category := models.Category{Name: "ANIMAL"}
+	assert.NoError(t, db.Create(&category).Error)
+	uploadDate := time.Date(2024, 3, 5, 13, 0, 0, 0, time.UTC)
+	image := models.Image{FileName: "cat.jpg", FilePath: "uploads/1/cat.jpg", UserID: user.ID, UploadDate: uploadDate}
+	assert.NoError(t, db.Create(&image).Error)
+
+	imageCategoryRepo := repositories.NewImageCategoryRepository(db)
+	assert.NoError(t, imageCategoryRepo.AddCategoryToImage(image.ID, category.ID))
+	assert.NoError(t, imageCategoryRepo.AddCategoryToImage(image.ID, category.ID)) // 이미 있는 매핑은 무시
+	categories, err := imageCategoryRepo.GetCategoriesByImageID(image.ID)
+	assert.NoError(t, err)
+	assert.Len(t, categories, 1)
+
+	categoryRepo := repositories.NewCategoryRepository(db)
+	uploads, err := categoryRepo.CountDailyUploadsByCategory(uploadDate.AddDate(0, 0, -1), uploadDate.AddDate(0, 0, 1), user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.DailyCategoryUpload{{Date: "2024-03-05", CategoryID: category.ID, Name: "ANIMAL", ImageCount: 1}}, uploads)
+}
@@ -0,0 +1,162 @@
+package test
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"github.com/zeze1004/image-hub-platform/services"
+	"testing"
+)
+
+// 태그명 정규화, 중복 제거 후 자동 생성되는지 테스트
+func TestAddTagsToImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockTagRepo := mocks.NewMockTagRepository(ctrl)
+	mockImageTagRepo := mocks.NewMockImageTagRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	imageID, userID := uint(1), uint(1)
+	mockImageRepo.EXPECT().GetImageByID(imageID).Return(&models.Image{ID: imageID, UserID: userID}, nil)
+	mockImageTagRepo.EXPECT().Transaction(gomock.Any()).DoAndReturn(
+		func(fn func(txRepo repositories.ImageTagRepository) error) error {
+			return fn(mockImageTagRepo)
+		})
+	gomock.InOrder(
+		mockTagRepo.EXPECT().GetOrCreateTagsByName([]string{"cat", "여행"}).
+			Return([]models.Tag{{ID: 10, Name: "cat"}, {ID: 11, Name: "여행"}}, nil),
+		mockImageTagRepo.EXPECT().LockImage(imageID).Return(nil),
+		mockImageTagRepo.EXPECT().GetTagIDsByImageID(imageID).Return([]uint{10}, nil),
+		mockImageTagRepo.EXPECT().AddTagsToImage(imageID, []uint{11}).Return(nil),
+		mockTagRepo.EXPECT().GetTagsByImageID(imageID).
+			Return([]models.Tag{{ID: 10, Name: "cat"}, {ID: 11, Name: "여행"}}, nil),
+	)
+
+	tagService := services.NewTagService(mockImageRepo, mockTagRepo, mockImageTagRepo, mockPermissionRepo)
+
+	tags, err := tagService.AddTagsToImage(imageID, userID, false, []string{"#Cat", " cat ", "여행"})
+
+	assert.NoError(t, err)
+	assert.Len(t, tags, 2)
+}
+
+// 이미 붙은 태그를 빼고 최대 개수를 넘으면 이미지를 잠근 트랜잭션 안에서 거절하고 추가하지 않는지 테스트
+func TestAddTagsToImageTooMany(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockTagRepo := mocks.NewMockTagRepository(ctrl)
+	mockImageTagRepo := mocks.NewMockImageTagRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	currentIDs := make([]uint, 30)
+	for i := range currentIDs {
+		currentIDs[i] = uint(i + 1)
+	}
+	mockImageTagRepo.EXPECT().Transaction(gomock.Any()).DoAndReturn(
+		func(fn func(txRepo repositories.ImageTagRepository) error) error {
+			return fn(mockImageTagRepo)
+		}).Times(2)
+	mockTagRepo.EXPECT().GetOrCreateTagsByName([]string{"cat"}).Return([]models.Tag{{ID: 1, Name: "cat"}}, nil)
+	mockTagRepo.EXPECT().GetOrCreateTagsByName([]string{"dog"}).Return([]models.Tag{{ID: 31, Name: "dog"}}, nil)
+	mockImageTagRepo.EXPECT().LockImage(uint(1)).Return(nil).Times(2)
+	mockImageTagRepo.EXPECT().GetTagIDsByImageID(uint(1)).Return(currentIDs, nil).Times(2)
+	mockImageTagRepo.EXPECT().AddTagsToImage(uint(1), []uint{}).Return(nil)
+	mockTagRepo.EXPECT().GetTagsByImageID(uint(1)).Return([]models.Tag{{ID: 1, Name: "cat"}}, nil)
+
+	tagService := services.NewTagService(mockImageRepo, mockTagRepo, mockImageTagRepo, mockPermissionRepo)
+
+	// 이미 붙은 태그는 최대 개수와 상관없이 그대로 성공
+	_, err := tagService.AddTagsToImage(1, 1, true, []string{"cat"})
+	assert.NoError(t, err)
+	_, err = tagService.AddTagsToImage(1, 1, true, []string{"dog"})
+	assert.ErrorIs(t, err, services.ErrTooManyTags)
+}
+
+// 관리자가 아니면 소유하거나 권한을 받은 이미지의 태그만 자동완성하는지 테스트
+func TestSearchTagsByPrefix(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockTagRepo := mocks.NewMockTagRepository(ctrl)
+	mockImageTagRepo := mocks.NewMockImageTagRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	mockTagRepo.EXPECT().GetTagsByPrefixAndUserID("ca", uint(1), 10).Return([]models.Tag{{ID: 1, Name: "cat"}}, nil)
+	mockTagRepo.EXPECT().GetTagsByPrefix("ca", 50).Return([]models.Tag{{ID: 1, Name: "cat"}, {ID: 2, Name: "car"}}, nil)
+
+	tagService := services.NewTagService(mockImageRepo, mockTagRepo, mockImageTagRepo, mockPermissionRepo)
+
+	tags, err := tagService.SearchTagsByPrefix("#CA", 1, false, 0)
+	assert.NoError(t, err)
+	assert.Len(t, tags, 1)
+	tags, err = tagService.SearchTagsByPrefix("ca", 2, true, 100)
+	assert.NoError(t, err)
+	assert.Len(t, tags, 2)
+}
+
+// 허용되지 않는 문자가 포함된 태그명은 거절되는지 테스트
+func TestAddTagsToImageInvalidName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockTagRepo := mocks.NewMockTagRepository(ctrl)
+	mockImageTagRepo := mocks.NewMockImageTagRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	tagService := services.NewTagService(mockImageRepo, mockTagRepo, mockImageTagRepo, mockPermissionRepo)
+
+	for _, name := range []string{"", "#", "hello world", "a%b"} {
+		_, err := tagService.AddTagsToImage(1, 1, true, []string{name})
+		assert.True(t, errors.Is(err, services.ErrInvalidTagName), "태그명 %q", name)
+	}
+}
+
+// 다른 사용자의 이미지에는 태그를 추가할 수 없는지 테스트
+func TestAddTagsToImageNotOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockTagRepo := mocks.NewMockTagRepository(ctrl)
+	mockImageTagRepo := mocks.NewMockImageTagRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	mockImageRepo.EXPECT().GetImageByID(uint(1)).Return(&models.Image{ID: 1, UserID: 2}, nil)
+	mockPermissionRepo.EXPECT().GetPermissionRole(uint(1), uint(1)).Return("", nil)
+
+	tagService := services.NewTagService(mockImageRepo, mockTagRepo, mockImageTagRepo, mockPermissionRepo)
+
+	_, err := tagService.AddTagsToImage(1, 1, false, []string{"cat"})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "권한이 없습니다")
+}
+
+// 이미지에 붙어있지 않은 태그 삭제 시 ErrTagNotFound 반환 테스트
+func TestRemoveTagFromImageNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockTagRepo := mocks.NewMockTagRepository(ctrl)
+	mockImageTagRepo := mocks.NewMockImageTagRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+
+	mockTagRepo.EXPECT().GetTagsByName([]string{"cat"}).Return([]models.Tag{{ID: 10, Name: "cat"}}, nil)
+	mockImageTagRepo.EXPECT().RemoveTagFromImage(uint(1), uint(10)).Return(int64(0), nil)
+
+	tagService := services.NewTagService(mockImageRepo, mockTagRepo, mockImageTagRepo, mockPermissionRepo)
+
+	err := tagService.RemoveTagFromImage(1, 1, true, "#CAT")
+
+	assert.True(t, errors.Is(err, services.ErrTagNotFound))
+}
@@ -0,0 +1,104 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zeze1004/image-hub-platform/mocks"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/services"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"image"
+	"image/jpeg"
+	"os"
+	"testing"
+	"time"
+)
+
+// 이미지를 두 번에 나눠 업로드하면 완료 시 이미지가 저장되고, 잘못된 위치의 이어쓰기는 거절되는지 테스트
+func TestResumableUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer os.RemoveAll("./uploads")
+
+	mockImageRepo := mocks.NewMockImageRepository(ctrl)
+	mockCategoryRepo := mocks.NewMockCategoryRepository(ctrl)
+	mockImageCategoryRepo := mocks.NewMockImageCategoryRepository(ctrl)
+	mockPermissionRepo := mocks.NewMockPermissionRepository(ctrl)
+	mockUploadRepo := mocks.NewMockUploadRepository(ctrl)
+
+	var saved models.UploadSession
+	mockUploadRepo.EXPECT().CreateUpload(gomock.Any()).DoAndReturn(func(upload *models.UploadSession) error {
+		saved = *upload
+		return nil
+	})
+	mockUploadRepo.EXPECT().GetUploadByID(gomock.Any()).DoAndReturn(func(uploadID string) (*models.UploadSession, error) {
+		if uploadID != saved.ID {
+			return nil, errors.New("record not found")
+		}
+		upload := saved
+		return &upload, nil
+	}).AnyTimes()
+	mockUploadRepo.EXPECT().UpdateUploadOffset(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mockUploadRepo.EXPECT().DeleteUpload(gomock.Any()).Return(nil)
+	mockImageRepo.EXPECT().CreateImage(gomock.Any(), gomock.Any()).DoAndReturn(func(image *models.Image, categoryIDs []uint) error {
+		image.ID = 10
+		return nil
+	})
+
+	fileStorage := storage.NewLocalStorage()
+	imageService := services.NewImageService(mockImageRepo, mockCategoryRepo, mockImageCategoryRepo, mockPermissionRepo, fileStorage, testConfig.Storage, testConfig.Image, nil)
+	uploadService := services.NewUploadService(mockUploadRepo, imageService, fileStorage, testConfig.Storage)
+
+	var imgBuf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&imgBuf, image.NewRGBA(image.Rect(0, 0, 100, 100)), nil))
+	data := imgBuf.Bytes()
+	half := int64(len(data) / 2)
+
+	upload, err := uploadService.CreateUpload(1, int64(len(data)), services.UploadMetadata{FileName: "../resumable.jpg"})
+	assert.NoError(t, err)
+	assert.Equal(t, "resumable.jpg", upload.FileName)
+
+	upload, uploadedImage, _, err := uploadService.WriteChunk(upload.ID, 1, 0, bytes.NewReader(data[:half]))
+	assert.NoError(t, err)
+	assert.Nil(t, uploadedImage)
+	assert.Equal(t, half, upload.Offset)
+
+	// 이미 업로드된 위치부터 다시 쓰려고 하면 거절
+	_, _, _, err = uploadService.WriteChunk(upload.ID, 1, 0, bytes.NewReader(data))
+	assert.True(t, errors.Is(err, services.ErrUploadOffsetMismatch))
+
+	// 다른 사용자는 업로드를 찾을 수 없음
+	_, err = uploadService.GetUpload(upload.ID, 2)
+	assert.True(t, errors.Is(err, services.ErrUploadNotFound))
+
+	upload, uploadedImage, _, err = uploadService.WriteChunk(upload.ID, 1, half, bytes.NewReader(data[half:]))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(data)), upload.Offset)
+	assert.Equal(t, uint(10), uploadedImage.ID)
+	assert.Equal(t, "resumable.jpg", uploadedImage.FileName)
+	assert.NotEmpty(t, uploadedImage.ContentHash)
+}
+
+// 만료된 업로드는 이어쓸 수 없고 정리 작업에서 삭제되는지 테스트
+func TestPurgeExpiredUploads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer os.RemoveAll("./uploads")
+
+	mockUploadRepo := mocks.NewMockUploadRepository(ctrl)
+	expired := models.UploadSession{ID: "expired", UserID: 1, Length: 10, ExpiresAt: time.Now().Add(-time.Minute)}
+	mockUploadRepo.EXPECT().GetUploadByID("expired").Return(&expired, nil)
+	mockUploadRepo.EXPECT().GetExpiredUploads(gomock.Any()).Return([]models.UploadSession{expired}, nil)
+	mockUploadRepo.EXPECT().DeleteUpload("expired").Return(nil)
+
+	uploadService := services.NewUploadService(mockUploadRepo, nil, storage.NewLocalStorage(), testConfig.Storage)
+
+	_, _, _, err := uploadService.WriteChunk("expired", 1, 0, bytes.NewReader([]byte("data")))
+	assert.True(t, errors.Is(err, services.ErrUploadExpired))
+
+	purged, err := uploadService.PurgeExpiredUploads()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purged)
+}
@@ -1,11 +1,23 @@
 package utils
 
 import (
+	"errors"
 	"github.com/golang-jwt/jwt/v4"
 	"time"
 )
 
-var jwtSecret = []byte("image-hub-secret-key")
+var errJWTSecretNotConfigured = errors.New("JWT 서명 키가 설정되지 않았습니다")
+
+// JWT 설정의 서명 키와 유효 기간으로 토큰을 발급하고 검증
+type JWT struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewJWT 토큰 서명 키와 유효 기간으로 JWT 생성
+func NewJWT(secret string, ttl time.Duration) *JWT {
+	return &JWT{secret: []byte(secret), ttl: ttl}
+}
 
 type Claims struct {
 	UserID uint   `json:"user_id"`
@@ -13,23 +25,29 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-func GenerateToken(userID uint, role string) (string, error) {
+func (j *JWT) GenerateToken(userID uint, role string) (string, error) {
+	if len(j.secret) == 0 {
+		return "", errJWTSecretNotConfigured
+	}
 	claims := &Claims{
 		UserID: userID,
 		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.ttl)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	return token.SignedString(j.secret)
 }
 
-func ParseToken(tokenStr string) (*Claims, error) {
+func (j *JWT) ParseToken(tokenStr string) (*Claims, error) {
+	if len(j.secret) == 0 {
+		return nil, errJWTSecretNotConfigured
+	}
 	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return jwtSecret, nil
+		return j.secret, nil
 	})
 	if err != nil {
 		return nil, err
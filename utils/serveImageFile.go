@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/zeze1004/image-hub-platform/storage"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"time"
+)
+
+// 이미지 종류별 Cache-Control 정책
+const (
+	// CacheControlThumbnail 썸네일은 작고 자주 조회되므로 하루 동안 재검증 없이 캐시
+	CacheControlThumbnail = "private, max-age=86400"
+	// CacheControlOriginal 원본은 캐시하되 매번 ETag로 재검증해서 변경이 없으면 304로 응답
+	CacheControlOriginal = "private, no-cache"
+	// CacheControlShare 공유 링크는 조회 수를 세고 언제든 폐기될 수 있으므로 캐시하지 않음
+	CacheControlShare = "private, no-store"
+)
+
+// ServeImageFile ETag, Last-Modified, Cache-Control 헤더와 함께 저장소의 이미지 파일 반환
+// If-None-Match, If-Modified-Since 조건부 요청은 304, Range 요청은 206으로 응답
+// downloadName이 있으면 해당 파일명으로 다운로드되도록 Content-Disposition 설정
+func ServeImageFile(ctx *gin.Context, store storage.Storage, filePath, etag string, modTime time.Time, cacheControl, downloadName string) {
+	size, err := store.Size(filePath)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "이미지 파일을 찾을 수 없습니다"})
+		return
+	}
+	file, err := store.Open(filePath)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "이미지 파일을 찾을 수 없습니다"})
+		return
+	}
+	defer file.Close()
+
+	if etag != "" {
+		ctx.Header("ETag", fmt.Sprintf("%q", etag))
+	}
+	ctx.Header("Cache-Control", cacheControl)
+	if downloadName != "" {
+		ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": downloadName}))
+	}
+
+	// Content-Type은 파일 확장자, 없으면 파일 내용으로 결정
+	content, ok := file.(io.ReadSeeker)
+	if !ok {
+		content = &forwardSeeker{reader: file, size: size}
+	}
+	http.ServeContent(ctx.Writer, ctx.Request, filepath.Base(filePath), modTime, content)
+}
+
+// forwardSeeker 탐색할 수 없는 저장소 파일을 http.ServeContent에 넘기기 위한 io.ReadSeeker
+// 크기는 저장소의 Size로 알려주고, 앞으로 탐색할 때는 건너뛸 만큼 읽어서 버리므로 Range 요청 하나를 처리할 수 있음
+type forwardSeeker struct {
+	reader   io.Reader
+	size     int64
+	position int64 // Seek으로 옮긴 위치
+	consumed int64 // reader에서 실제로 읽은 바이트 수
+}
+
+func (f *forwardSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		f.position = offset
+	case io.SeekCurrent:
+		f.position += offset
+	case io.SeekEnd:
+		f.position = f.size + offset
+	}
+	if f.position < 0 {
+		return 0, errors.New("파일 시작보다 앞으로 탐색할 수 없습니다")
+	}
+	return f.position, nil
+}
+
+func (f *forwardSeeker) Read(p []byte) (int, error) {
+	if f.position < f.consumed {
+		return 0, errors.New("이미 읽은 위치로 되돌아갈 수 없습니다")
+	}
+	if f.position > f.consumed {
+		skipped, err := io.CopyN(io.Discard, f.reader, f.position-f.consumed)
+		f.consumed += skipped
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.reader.Read(p)
+	f.consumed += int64(n)
+	f.position = f.consumed
+	return n, err
+}
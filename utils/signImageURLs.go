@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/signedurl"
+)
+
+// SignImageURL 응답으로 내려줄 이미지에 인증 없이 조회할 수 있는 원본, 썸네일의 서명된 URL 설정
+// 서명된 URL은 응답에만 필요하므로 모델을 조회할 때가 아니라 컨트롤러가 응답을 만들 때 설정
+func SignImageURL(signer *signedurl.Signer, image *models.Image) {
+	if image == nil {
+		return
+	}
+	image.URL = signer.Sign(image.FilePath)
+	image.ThumbnailURL = signer.Sign(image.ThumbnailPath)
+}
+
+// SignImageURLs 이미지 목록에 서명된 URL 설정
+func SignImageURLs(signer *signedurl.Signer, images []models.Image) {
+	for i := range images {
+		SignImageURL(signer, &images[i])
+	}
+}
@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+)
+
+// ValidateAlbumOwnership 앨범 소유자 검증, 검증된 앨범을 반환
+func ValidateAlbumOwnership(albumRepo repositories.AlbumRepository, albumID, userID uint) (*models.Album, error) {
+	album, err := albumRepo.GetAlbumByID(albumID)
+	if err != nil {
+		return nil, fmt.Errorf("앨범을 찾을 수 없습니다: %v", err)
+	}
+	if album.UserID != userID {
+		return nil, fmt.Errorf("앨범에 대한 권한이 없습니다")
+	}
+	return album, nil
+}
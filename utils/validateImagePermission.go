@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"github.com/zeze1004/image-hub-platform/models"
+	"github.com/zeze1004/image-hub-platform/repositories"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrImageNotFound         = errors.New("이미지를 찾을 수 없습니다")
+	ErrImagePermissionDenied = errors.New("이미지에 대한 권한이 없습니다")
+)
+
+// ImagePermission 이미지 작업에 필요한 권한 수준
+type ImagePermission int
+
+const (
+	PermissionView  ImagePermission = iota + 1 // 이미지, 카테고리 조회
+	PermissionEdit                             // 카테고리, 태그 수정
+	PermissionOwner                            // 이미지 삭제, 권한 관리 등 소유자만 가능한 작업
+)
+
+// ValidateImagePermission 이미지 소유자이거나 소유자에게 필요한 권한을 부여받았는지 검증
+func ValidateImagePermission(imageRepo repositories.ImageRepository, permissionRepo repositories.PermissionRepository, imageID, userID uint, required ImagePermission) error {
+	image, err := GetImage(imageRepo, imageID)
+	if err != nil {
+		return err
+	}
+	if image.UserID == userID {
+		return nil
+	}
+	if required == PermissionOwner {
+		return ErrImagePermissionDenied
+	}
+
+	role, err := permissionRepo.GetPermissionRole(imageID, userID)
+	if err != nil {
+		return fmt.Errorf("이미지 권한을 확인하는데 실패했습니다: %v", err)
+	}
+	if !roleAllows(role, required) {
+		return ErrImagePermissionDenied
+	}
+	return nil
+}
+
+// ValidateImagesPermission 이미 조회한 여러 이미지의 권한을 한 번에 검증
+// 소유하지 않은 이미지의 권한만 한 번의 쿼리로 조회하고, 권한이 없으면 첫 번째 이미지 ID와 함께 에러 반환
+func ValidateImagesPermission(permissionRepo repositories.PermissionRepository, images []models.Image, userID uint, required ImagePermission) error {
+	var sharedImageIDs []uint
+	for _, image := range images {
+		if image.UserID == userID {
+			continue
+		}
+		if required == PermissionOwner {
+			return fmt.Errorf("%w: 이미지 %d", ErrImagePermissionDenied, image.ID)
+		}
+		sharedImageIDs = append(sharedImageIDs, image.ID)
+	}
+	if len(sharedImageIDs) == 0 {
+		return nil
+	}
+
+	permissions, err := permissionRepo.GetPermissionsByImageIDsAndUserID(sharedImageIDs, userID)
+	if err != nil {
+		return fmt.Errorf("이미지 권한을 확인하는데 실패했습니다: %v", err)
+	}
+	roles := make(map[uint]string, len(permissions))
+	for _, permission := range permissions {
+		roles[permission.ImageID] = permission.Role
+	}
+	for _, imageID := range sharedImageIDs {
+		if !roleAllows(roles[imageID], required) {
+			return fmt.Errorf("%w: 이미지 %d", ErrImagePermissionDenied, imageID)
+		}
+	}
+	return nil
+}
+
+// roleAllows 부여받은 역할로 필요한 권한의 작업을 할 수 있는지 확인
+func roleAllows(role string, required ImagePermission) bool {
+	switch {
+	case role == models.ImageRoleEditor:
+		return required != PermissionOwner
+	case role == models.ImageRoleViewer:
+		return required == PermissionView
+	default:
+		return false
+	}
+}
+
+// GetImage 이미지 조회, 없는 이미지는 ErrImageNotFound로 감싸서 DB 에러와 구분
+// 권한을 검증할 때와 권한 검증 없이 관리자가 조회할 때 사용
+func GetImage(imageRepo repositories.ImageRepository, imageID uint) (*models.Image, error) {
+	image, err := imageRepo.GetImageByID(imageID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %v", ErrImageNotFound, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("이미지를 가져오는데 실패했습니다: %v", err)
+	}
+	return image, nil
+}
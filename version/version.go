@@ -0,0 +1,44 @@
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// 빌드할 때 -ldflags로 설정하는 빌드 정보
+//
+//	go build -ldflags "-X github.com/zeze1004/image-hub-platform/version.Commit=$(git rev-parse HEAD) \
+//		-X github.com/zeze1004/image-hub-platform/version.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)" ./cmd
+var (
+	Commit    string
+	BuildTime string
+)
+
+// Info /version으로 내려주는 빌드 정보
+type Info struct {
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get 빌드 정보 반환, -ldflags로 설정하지 않은 값은 go build가 기록한 VCS 정보를 사용하고 그것도 없으면 unknown
+func Get() Info {
+	info := Info{Commit: Commit, BuildTime: BuildTime, GoVersion: runtime.Version()}
+	if buildInfo, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range buildInfo.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = setting.Value
+			case setting.Key == "vcs.time" && info.BuildTime == "":
+				info.BuildTime = setting.Value
+			}
+		}
+	}
+	if info.Commit == "" {
+		info.Commit = "unknown"
+	}
+	if info.BuildTime == "" {
+		info.BuildTime = "unknown"
+	}
+	return info
+}